@@ -0,0 +1,164 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"github.com/bugVanisher/gunit-test-report/report"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+	"io/ioutil"
+	"os"
+	"sort"
+)
+
+// configFileName is the name of the configuration file looked for in the working directory when --config is not set.
+const configFileName = ".gunit-test-report.yaml"
+
+// configFlagName is the name of the flag of the configuration file, which cannot be set in the file itself.
+const configFlagName = "config"
+
+func initConfigCommand(tmplData *report.Data, flags *cmdFlags) *cobra.Command {
+	configCmd := &cobra.Command{
+		Use:   "config",
+		Short: "Manages the configuration file",
+		Long: "The configuration file is " + configFileName + " in the working directory, or the file given with --config.\n" +
+			"Its keys are the names of the flags, e.g. \"title\", \"groupSize\" or \"redact-key\"; list flags take YAML lists.\n" +
+			"Flags set on the command line override the values of the file.",
+		// the subcommands read the configuration file themselves
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return nil
+		},
+	}
+	validateCmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Checks the configuration file",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fileName, config, err := loadConfigFile(flags.configFlag)
+			if err != nil {
+				return err
+			}
+			if fileName == "" {
+				return fmt.Errorf("no configuration file: %s not found in the working directory", configFileName)
+			}
+			if err := applyConfig(cmd.Root(), fileName, config); err != nil {
+				return err
+			}
+			if err := initTemplateData(tmplData, flags); err != nil {
+				return fmt.Errorf("%s: %w", fileName, err)
+			}
+			if _, err := newRedactor(flags); err != nil {
+				return fmt.Errorf("%s: %w", fileName, err)
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "%s is valid\n", fileName)
+			return err
+		},
+	}
+	configCmd.AddCommand(validateCmd)
+	return configCmd
+}
+
+// loadConfigFile reads the configuration file given with --config, or else the one of the working directory if any.
+// It returns an empty file name if there is no configuration file.
+func loadConfigFile(configFlag string) (string, map[string]*yaml.Node, error) {
+	fileName := configFlag
+	if fileName == "" {
+		if _, err := os.Stat(configFileName); err != nil {
+			if os.IsNotExist(err) {
+				return "", nil, nil
+			}
+			return "", nil, err
+		}
+		fileName = configFileName
+	}
+	content, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return "", nil, err
+	}
+	var document yaml.Node
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return "", nil, fmt.Errorf("%s: %w", fileName, err)
+	}
+	config := map[string]*yaml.Node{}
+	if len(document.Content) == 0 {
+		// an empty file
+		return fileName, config, nil
+	}
+	options := document.Content[0]
+	if options.Kind != yaml.MappingNode {
+		return "", nil, fmt.Errorf("%s:%d: the options must be a mapping, e.g. \"title: my report\"", fileName, options.Line)
+	}
+	for i := 0; i+1 < len(options.Content); i += 2 {
+		config[options.Content[i].Value] = options.Content[i+1]
+	}
+	return fileName, config, nil
+}
+
+// applyConfig sets the flags of the command tree from the values of the configuration file, unless they were set on
+// the command line. Unlike FlagSet.Set, setting the value of a flag does not mark it as changed.
+func applyConfig(root *cobra.Command, fileName string, config map[string]*yaml.Node) error {
+	var names []string
+	for name := range config {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		node := config[name]
+		if name == configFlagName {
+			return fmt.Errorf("%s:%d: %q cannot be set in the configuration file", fileName, node.Line, name)
+		}
+		flag := lookupFlag(root, name)
+		if flag == nil {
+			return fmt.Errorf("%s:%d: unknown option %q", fileName, node.Line, name)
+		}
+		if flag.Changed {
+			continue
+		}
+		if err := setFlagValue(flag, node); err != nil {
+			return fmt.Errorf("%s:%d: invalid value for %q: %w", fileName, node.Line, name, err)
+		}
+	}
+	return nil
+}
+
+// lookupFlag returns the flag of the given name of any command of the tree, or nil. The flags are shared with the
+// subcommands that inherit them.
+func lookupFlag(cmd *cobra.Command, name string) *pflag.Flag {
+	if flag := cmd.PersistentFlags().Lookup(name); flag != nil {
+		return flag
+	}
+	if flag := cmd.Flags().Lookup(name); flag != nil {
+		return flag
+	}
+	for _, subCmd := range cmd.Commands() {
+		if flag := lookupFlag(subCmd, name); flag != nil {
+			return flag
+		}
+	}
+	return nil
+}
+
+// setFlagValue sets a flag from a scalar, or from a list for the list flags such as --redact; a list replaces the
+// default value of the flag.
+func setFlagValue(flag *pflag.Flag, node *yaml.Node) error {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		return flag.Value.Set(node.Value)
+	case yaml.SequenceNode:
+		sliceValue, ok := flag.Value.(pflag.SliceValue)
+		if !ok {
+			return errors.New("a single value is expected")
+		}
+		var values []string
+		for _, item := range node.Content {
+			if item.Kind != yaml.ScalarNode {
+				return errors.New("a list of values is expected")
+			}
+			values = append(values, item.Value)
+		}
+		return sliceValue.Replace(values)
+	default:
+		return errors.New("a value or a list of values is expected")
+	}
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRootCommandWithConfigFile(t *testing.T) {
+	assertions := assert.New(t)
+	dir, err := ioutil.TempDir("", "go-test-report")
+	assertions.Nil(err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	plainFileName, _ := writeSampleTestEventFiles(t, dir)
+	configFileName := filepath.Join(dir, "config.yaml")
+	config := "title: from the file\n" +
+		"groupSize: 5\n" +
+		"output: " + filepath.Join(dir, "report.html") + "\n" +
+		"redact-key: [X-Api-Key, Cookie]\n" +
+		"baseline: baseline.json\n"
+	assertions.Nil(ioutil.WriteFile(configFileName, []byte(config), 0644))
+
+	rootCmd, tmplData, flags := initRootCommand()
+	rootCmd.SetOut(bytes.NewBufferString(""))
+	rootCmd.SetArgs([]string{"--config", configFileName, "--title", "from the flag", plainFileName})
+	assertions.Nil(rootCmd.Execute())
+	assertions.Equal("from the flag", tmplData.ReportTitle)
+	assertions.Equal(5, tmplData.NumOfTestsPerGroup)
+	assertions.Equal([]string{"X-Api-Key", "Cookie"}, flags.redactKeys)
+	// the options of the other commands are accepted too
+	assertions.Equal("baseline.json", flags.baselineFlag)
+	assertions.FileExists(filepath.Join(dir, "report.html"))
+}
+
+func TestConfigValidateCommand(t *testing.T) {
+	assertions := assert.New(t)
+	dir, err := ioutil.TempDir("", "go-test-report")
+	assertions.Nil(err)
+	workingDir, err := os.Getwd()
+	assertions.Nil(err)
+	defer func() {
+		_ = os.Chdir(workingDir)
+		_ = os.RemoveAll(dir)
+	}()
+	assertions.Nil(os.Chdir(dir))
+	validate := func(config string) (string, error) {
+		assertions.Nil(ioutil.WriteFile(configFileName, []byte(config), 0644))
+		buffer := bytes.NewBufferString("")
+		rootCmd, _, _ := initRootCommand()
+		rootCmd.SetOut(buffer)
+		rootCmd.SetErr(buffer)
+		rootCmd.SetArgs([]string{"config", "validate"})
+		err := rootCmd.Execute()
+		return buffer.String(), err
+	}
+
+	output, err := validate("title: nightly\nfail-on: [failed, no-tests]\nredact: ['token=(\\w+)']\n")
+	assertions.Nil(err)
+	assertions.Equal(configFileName+" is valid\n", output)
+
+	_, err = validate("title: nightly\nsortBy: name\n")
+	assertions.Error(err)
+	assertions.Equal(configFileName+`:2: unknown option "sortBy"`, err.Error())
+
+	_, err = validate("groupSize: many\n")
+	assertions.Error(err)
+	assertions.Contains(err.Error(), configFileName+`:1: invalid value for "groupSize"`)
+
+	_, err = validate("title: [a, b]\n")
+	assertions.Error(err)
+	assertions.Equal(configFileName+`:1: invalid value for "title": a single value is expected`, err.Error())
+
+	_, err = validate("- title\n")
+	assertions.Error(err)
+	assertions.Contains(err.Error(), configFileName+":1: the options must be a mapping")
+
+	_, err = validate("sort: random\n")
+	assertions.Error(err)
+	assertions.Contains(err.Error(), `invalid sort order "random"`)
+
+	assertions.Nil(os.Remove(configFileName))
+	rootCmd, _, _ := initRootCommand()
+	rootCmd.SetOut(bytes.NewBufferString(""))
+	rootCmd.SetErr(bytes.NewBufferString(""))
+	rootCmd.SetArgs([]string{"config", "validate"})
+	assertions.Error(rootCmd.Execute())
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"github.com/bugVanisher/gunit-test-report/report"
+	"github.com/spf13/cobra"
+	"path/filepath"
+	"time"
+)
+
+func initDiffCommand(tmplData *report.Data, flags *cmdFlags) *cobra.Command {
+	diffCmd := &cobra.Command{
+		Use:   "diff [flags] --baseline file [files...]",
+		Short: "Compares the test results against a baseline",
+		Long: "Generates the report of a test run along with its differences from a baseline run: new failures, fixed,\n" +
+			"added and removed tests, tests whose gunit title changed, and tests whose duration regressed. The baseline\n" +
+			"is a report exported with --json-out, a run file of --history-dir, or a history directory (its latest run).\n" +
+			"The test results are read like those of the root command, from stdin or from files.",
+		Args: cobra.ArbitraryArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := initTemplateData(tmplData, flags); err != nil {
+				return err
+			}
+			if flags.baselineFlag == "" {
+				return errors.New("the baseline flag is required")
+			}
+			if len(args) == 0 {
+				if err := checkIfStdinIsPiped(); err != nil {
+					return err
+				}
+				args = []string{stdinFileName}
+			}
+			startTime := time.Now()
+			baseline, err := report.ReadBaseline(flags.baselineFlag)
+			if err != nil {
+				return err
+			}
+			var shards []*shardResults
+			for _, fileName := range args {
+				shard, err := readResultsFile(cmd, fileName, flags)
+				if err != nil {
+					return err
+				}
+				shards = append(shards, shard)
+			}
+			current := mergeShardResults(shards)
+			tmplData.Diff = report.DiffTestResults(baseline, current.testsInPackages, flags.durationThreshold)
+			tmplData.Diff.Baseline = filepath.Base(flags.baselineFlag)
+			tmplData.BuildFailedPackages = current.buildFailedPackages
+			err = writeReports(cmd, tmplData, flags, current.allPackageNames, current.testsInPackages, current.failedTestNames, topLevelElapsedTime(current.testsInPackages), startTime)
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprint(cmd.OutOrStdout(), tmplData.Diff.Summary()); err != nil {
+				return err
+			}
+			return checkFailOn(cmd, tmplData, flags)
+		},
+	}
+	diffCmd.Flags().StringVar(&flags.baselineFlag,
+		"baseline",
+		"",
+		"the baseline: a report exported with --json-out, a history run file or a history directory")
+	diffCmd.Flags().IntVar(&flags.durationThreshold,
+		"duration-threshold",
+		20,
+		"the minimum increase of the duration of a test, in percent, reported as a regression")
+	return diffCmd
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"bytes"
+	"github.com/bugVanisher/gunit-test-report/report"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDiffCommand(t *testing.T) {
+	assertions := assert.New(t)
+	dir, err := ioutil.TempDir("", "go-test-report")
+	assertions.Nil(err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	baseline := &report.Data{
+		TestResults: []*report.TestGroup{
+			{
+				PackageName: "foo",
+				TestResults: []*report.TestStatus{
+					{TestName: "TestFunc1", Package: "foo", ElapsedTime: 1, Passed: true},
+					{TestName: "TestFunc2", Package: "foo", ElapsedTime: 1},
+					{TestName: "TestFunc3", Package: "foo", ElapsedTime: 1, Passed: true},
+					{TestName: "TestFunc4(sample title)", Title: "sample title", Package: "foo", ElapsedTime: 1, Passed: true},
+				},
+			},
+		},
+	}
+	var baselineContent bytes.Buffer
+	assertions.Nil(report.RenderJSON(baseline, &baselineContent))
+	assertions.Nil(ioutil.WriteFile(filepath.Join(dir, "baseline.json"), baselineContent.Bytes(), 0644))
+	current := `{"Time":"2020-07-10T01:24:44.269511-05:00","Action":"run","Package":"foo","Test":"TestFunc1"}
+{"Time":"2020-07-10T01:24:45.270311-05:00","Action":"fail","Package":"foo","Test":"TestFunc1","Elapsed":1}
+{"Time":"2020-07-10T01:24:45.270311-05:00","Action":"run","Package":"foo","Test":"TestFunc2"}
+{"Time":"2020-07-10T01:24:47.270311-05:00","Action":"pass","Package":"foo","Test":"TestFunc2","Elapsed":1.05}
+{"Time":"2020-07-10T01:24:47.270311-05:00","Action":"run","Package":"foo","Test":"TestFunc3"}
+{"Time":"2020-07-10T01:24:49.270311-05:00","Action":"pass","Package":"foo","Test":"TestFunc3","Elapsed":2}
+{"Time":"2020-07-10T01:24:49.270311-05:00","Action":"run","Package":"foo","Test":"TestFunc5"}
+{"Time":"2020-07-10T01:24:49.270311-05:00","Action":"skip","Package":"foo","Test":"TestFunc5","Elapsed":0}
+`
+	assertions.Nil(ioutil.WriteFile(filepath.Join(dir, "current.json"), []byte(current), 0644))
+
+	buffer := bytes.NewBufferString("")
+	rootCmd, tmplData, _ := initRootCommand()
+	rootCmd.SetOut(buffer)
+	rootCmd.SetArgs([]string{"diff", "--output", filepath.Join(dir, "report.html"),
+		"--baseline", filepath.Join(dir, "baseline.json"), filepath.Join(dir, "current.json")})
+	assertions.Nil(rootCmd.Execute())
+	assertions.FileExists(filepath.Join(dir, "report.html"))
+	output := buffer.String()
+	assertions.Contains(output, "[go-test-report] compared with baseline.json: 1 new failure(s), 1 fixed, 1 added, 1 removed, 0 renamed, 1 slower\n"+
+		"  new failure: foo.TestFunc1\n"+
+		"  fixed:       foo.TestFunc2\n"+
+		"  added:       foo.TestFunc5 (skipped)\n"+
+		"  removed:     foo.TestFunc4(sample title)\n"+
+		"  slower:      foo.TestFunc3 1.000s -> 2.000s (+100%)\n")
+	assertions.Len(tmplData.Diff.NewFailures, 1)
+
+	report, err := ioutil.ReadFile(filepath.Join(dir, "report.html"))
+	assertions.Nil(err)
+	assertions.Contains(string(report), "Changes since baseline.json:")
+}
@@ -17,7 +17,7 @@ func init() {
 }
 
 func main() {
-	outputFile1, _ := os.Create("../embedded_assets.go")
+	outputFile1, _ := os.Create("../report/embedded_assets.go")
 	writer := bufio.NewWriter(outputFile1)
 	defer func() {
 		if err := writer.Flush(); err != nil {
@@ -29,7 +29,7 @@ func main() {
 	}()
 	dst := make([]byte, hex.EncodedLen(len(htmlTemplate)))
 	hex.Encode(dst, htmlTemplate)
-	_, _ = writer.WriteString(fmt.Sprintf("package main\n\nvar testReportHTMLTemplate = `%s`", string(dst)))
+	_, _ = writer.WriteString(fmt.Sprintf("package report\n\nvar testReportHTMLTemplate = `%s`", string(dst)))
 	dst = make([]byte, hex.EncodedLen(len(jsCode)))
 	hex.Encode(dst, jsCode)
 	_, _ = writer.WriteString(fmt.Sprintf("\n\nvar testReportJsCode = `%s`\n", string(dst)))
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"github.com/bugVanisher/gunit-test-report/report"
+	"github.com/spf13/cobra"
+	"strings"
+)
+
+const (
+	failOnFailed     = "failed"
+	failOnBuildError = "build-error"
+	failOnNoTests    = "no-tests"
+)
+
+var failOnConditions = []string{failOnFailed, failOnBuildError, failOnNoTests}
+
+// exitCodeError is returned by a command that wants the process to terminate with a specific exit code.
+type exitCodeError struct {
+	code    int
+	message string
+}
+
+func (e *exitCodeError) Error() string {
+	return e.message
+}
+
+func validateFailOnFlag(flags *cmdFlags) error {
+	for _, condition := range flags.failOn {
+		valid := false
+		for _, failOnCondition := range failOnConditions {
+			if condition == failOnCondition {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return fmt.Errorf(`invalid fail-on condition "%s"; valid conditions are: %s`,
+				condition, strings.Join(failOnConditions, ", "))
+		}
+	}
+	return nil
+}
+
+// checkFailOn returns an exitCodeError if the generated report matches any of the conditions of the fail-on flag.
+func checkFailOn(cmd *cobra.Command, tmplData *report.Data, flags *cmdFlags) error {
+	var reasons []string
+	for _, condition := range flags.failOn {
+		switch condition {
+		case failOnFailed:
+			if tmplData.NumOfTestFailed > 0 {
+				reasons = append(reasons, fmt.Sprintf("%d test(s) failed", tmplData.NumOfTestFailed))
+			}
+		case failOnBuildError:
+			if len(tmplData.BuildFailedPackages) > 0 {
+				reasons = append(reasons, fmt.Sprintf("%d package(s) failed to build: %s",
+					len(tmplData.BuildFailedPackages), strings.Join(tmplData.BuildFailedPackages, ", ")))
+			}
+		case failOnNoTests:
+			if tmplData.NumOfTests == 0 {
+				reasons = append(reasons, "no tests were run")
+			}
+		}
+	}
+	if len(reasons) == 0 {
+		return nil
+	}
+	cmd.SilenceUsage = true
+	return &exitCodeError{
+		code:    1,
+		message: strings.Join(reasons, "; "),
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bytes"
+	"github.com/bugVanisher/gunit-test-report/report"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFailOnFlagIfInvalidCondition(t *testing.T) {
+	assertions := assert.New(t)
+	buffer := bytes.NewBufferString("")
+	rootCmd, _, _ := initRootCommand()
+	rootCmd.SetOut(buffer)
+	rootCmd.SetArgs([]string{"--fail-on", "failed,unknown"})
+	rootCmdErr := rootCmd.Execute()
+	assertions.NotNil(rootCmdErr)
+	assertions.Equal(`invalid fail-on condition "unknown"; valid conditions are: failed, build-error, no-tests`, rootCmdErr.Error())
+}
+
+func TestCheckFailOn(t *testing.T) {
+	assertions := assert.New(t)
+	cmd := &cobra.Command{}
+	tmplData := &report.Data{
+		NumOfTestFailed:     2,
+		NumOfTests:          2,
+		BuildFailedPackages: []string{"package2"},
+	}
+
+	assertions.Nil(checkFailOn(cmd, tmplData, &cmdFlags{}))
+	assertions.Nil(checkFailOn(cmd, tmplData, &cmdFlags{failOn: []string{failOnNoTests}}))
+
+	err := checkFailOn(cmd, tmplData, &cmdFlags{failOn: []string{failOnFailed, failOnBuildError}})
+	assertions.Error(err)
+	exitErr, ok := err.(*exitCodeError)
+	assertions.True(ok)
+	if ok {
+		assertions.Equal(1, exitErr.code)
+		assertions.Equal("2 test(s) failed; 1 package(s) failed to build: package2", exitErr.message)
+	}
+
+	err = checkFailOn(cmd, &report.Data{}, &cmdFlags{failOn: []string{failOnFailed, failOnNoTests}})
+	assertions.Error(err)
+	assertions.Equal("no tests were run", err.Error())
+}
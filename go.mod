@@ -5,8 +5,9 @@ go 1.13
 require (
 	github.com/smarty/gunit v1.5.0
 	github.com/spf13/cobra v1.1.3
+	github.com/spf13/pflag v1.0.5
 	github.com/stretchr/testify v1.6.1
-	gopkg.in/yaml.v3 v3.0.0-20200615113413-eeeca48fe776 // indirect
+	gopkg.in/yaml.v3 v3.0.0-20200615113413-eeeca48fe776
 )
 
 replace github.com/smarty/gunit => github.com/bugVanisher/gunit v0.0.0-20230720140633-d442ddcf00c1
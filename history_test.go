@@ -0,0 +1,32 @@
+package main
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRootCommandWithHistoryDir(t *testing.T) {
+	assertions := assert.New(t)
+	dir, err := ioutil.TempDir("", "go-test-report")
+	assertions.Nil(err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	plainFileName, gzipFileName := writeSampleTestEventFiles(t, dir)
+	for i := 0; i < 2; i++ {
+		buffer := bytes.NewBufferString("")
+		rootCmd, tmplData, _ := initRootCommand()
+		rootCmd.SetOut(buffer)
+		rootCmd.SetArgs([]string{"--output", filepath.Join(dir, "report.html"),
+			"--history-dir", filepath.Join(dir, "history"), plainFileName, gzipFileName})
+		assertions.Nil(rootCmd.Execute())
+		assertions.Len(tmplData.Trend.Runs, i+1)
+	}
+	report, err := ioutil.ReadFile(filepath.Join(dir, "report.html"))
+	assertions.Nil(err)
+	assertions.Contains(string(report), `class="passRateBar"`)
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"bufio"
+	"compress/gzip"
+	"io"
+	"os"
+	"strings"
+)
+
+// stdinFileName is the file argument designating the standard input.
+const stdinFileName = "-"
+
+// testEventInput reads the concatenated content of one or more test event files.
+type testEventInput struct {
+	io.Reader
+	closers []io.Closer
+}
+
+func (in *testEventInput) Close() error {
+	var closeErr error
+	for _, closer := range in.closers {
+		if err := closer.Close(); err != nil && closeErr == nil {
+			closeErr = err
+		}
+	}
+	return closeErr
+}
+
+// openTestEventFiles opens the given "go test -json" output files ("-" being the standard input) as a single
+// stream. Gzip-compressed files are decompressed transparently.
+func openTestEventFiles(fileNames []string) (*testEventInput, error) {
+	input := &testEventInput{}
+	var readers []io.Reader
+	for _, fileName := range fileNames {
+		var file *os.File
+		if fileName == stdinFileName {
+			file = os.Stdin
+		} else {
+			var err error
+			if file, err = os.Open(fileName); err != nil {
+				_ = input.Close()
+				return nil, err
+			}
+		}
+		input.closers = append(input.closers, file)
+		reader, err := decompressIfNeeded(bufio.NewReader(file))
+		if err != nil {
+			_ = input.Close()
+			return nil, err
+		}
+		if closer, ok := reader.(io.Closer); ok {
+			input.closers = append(input.closers, closer)
+		}
+		// the last line of a file may lack its line break
+		readers = append(readers, reader, strings.NewReader("\n"))
+	}
+	input.Reader = io.MultiReader(readers...)
+	return input, nil
+}
+
+// decompressIfNeeded returns a gzip reader if the content starts with the gzip magic number.
+func decompressIfNeeded(reader *bufio.Reader) (io.Reader, error) {
+	header, err := reader.Peek(2)
+	if err != nil || header[0] != 0x1f || header[1] != 0x8b {
+		return reader, nil
+	}
+	return gzip.NewReader(reader)
+}
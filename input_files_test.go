@@ -0,0 +1,73 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const (
+	sampleTestEvents1 = `{"Time":"2020-07-10T01:24:44.269511-05:00","Action":"run","Package":"foo","Test":"TestFunc1"}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"fail","Package":"foo","Test":"TestFunc1","Elapsed":0}`
+	sampleTestEvents2 = `{"Time":"2020-07-10T01:24:44.269511-05:00","Action":"run","Package":"bar","Test":"TestFunc2"}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"fail","Package":"bar","Test":"TestFunc2","Elapsed":0}
+`
+)
+
+func writeSampleTestEventFiles(t *testing.T, dir string) (string, string) {
+	assertions := assert.New(t)
+	plainFileName := filepath.Join(dir, "shard1.jsonl")
+	assertions.Nil(ioutil.WriteFile(plainFileName, []byte(sampleTestEvents1), 0644))
+	var compressed bytes.Buffer
+	gzipWriter := gzip.NewWriter(&compressed)
+	_, err := gzipWriter.Write([]byte(sampleTestEvents2))
+	assertions.Nil(err)
+	assertions.Nil(gzipWriter.Close())
+	gzipFileName := filepath.Join(dir, "shard2.json.gz")
+	assertions.Nil(ioutil.WriteFile(gzipFileName, compressed.Bytes(), 0644))
+	return plainFileName, gzipFileName
+}
+
+func TestOpenTestEventFiles(t *testing.T) {
+	assertions := assert.New(t)
+	dir, err := ioutil.TempDir("", "go-test-report")
+	assertions.Nil(err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	plainFileName, gzipFileName := writeSampleTestEventFiles(t, dir)
+
+	input, err := openTestEventFiles([]string{plainFileName, gzipFileName})
+	assertions.Nil(err)
+	content, err := ioutil.ReadAll(input)
+	assertions.Nil(err)
+	assertions.Nil(input.Close())
+	assertions.Equal(sampleTestEvents1+"\n"+sampleTestEvents2+"\n", string(content))
+
+	_, err = openTestEventFiles([]string{plainFileName, filepath.Join(dir, "missing.json")})
+	assertions.Error(err)
+}
+
+func TestRootCommandWithFileArguments(t *testing.T) {
+	assertions := assert.New(t)
+	dir, err := ioutil.TempDir("", "go-test-report")
+	assertions.Nil(err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	plainFileName, gzipFileName := writeSampleTestEventFiles(t, dir)
+
+	buffer := bytes.NewBufferString("")
+	rootCmd, tmplData, _ := initRootCommand()
+	rootCmd.SetOut(buffer)
+	rootCmd.SetArgs([]string{"--output", filepath.Join(dir, "report.html"), plainFileName, gzipFileName})
+	rootCmdErr := rootCmd.Execute()
+	assertions.Nil(rootCmdErr)
+	assertions.FileExists(filepath.Join(dir, "report.html"))
+	assertions.Equal(2, tmplData.NumOfTestFailed)
+	assertions.Len(tmplData.TestResults, 2)
+}
@@ -2,165 +2,92 @@ package main
 
 import (
 	"bufio"
-	"bytes"
-	"encoding/hex"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"github.com/smarty/gunit"
+	"github.com/bugVanisher/gunit-test-report/report"
 	"github.com/spf13/cobra"
-	"go/ast"
-	"go/parser"
-	"go/token"
 	"go/types"
-	"html/template"
+	"io"
 	"os"
-	"os/exec"
-	"sort"
 	"strconv"
 	"strings"
 	"time"
 )
 
-type (
-	goTestOutputRow struct {
-		Time     string
-		TestName string `json:"Test"`
-		Action   string
-		Package  string
-		Elapsed  float64
-		Output   string
-	}
-
-	testStatus struct {
-		TestName           string
-		Package            string
-		ElapsedTime        float64
-		Output             []string
-		Passed             bool
-		Skipped            bool
-		Omitted            bool
-		TestFileName       string
-		TestFunctionDetail testFunctionFilePos
-	}
-
-	templateData struct {
-		TestResultGroupIndicatorWidth  string
-		TestResultGroupIndicatorHeight string
-		TestResults                    []*testGroupData
-		NumOfTestPassed                int
-		NumOfTestFailed                int
-		NumOfTestSkipped               int
-		NumOfTests                     int
-		TestDuration                   time.Duration
-		ReportTitle                    string
-		JsCode                         template.JS
-		numOfTestsPerGroup             int
-		OutputFilename                 string
-		TestExecutionDate              string
-		FailedTestNames                []string
-	}
-
-	testGroupData struct {
-		FailureIndicator string
-		SkippedIndicator string
-		PackageName      string
-		TestResults      []*testStatus
-	}
-
-	cmdFlags struct {
-		titleFlag  string
-		sizeFlag   string
-		groupSize  int
-		outputFlag string
-		verbose    bool
-	}
-
-	goListJSONModule struct {
-		Path string
-		Dir  string
-		Main bool
-	}
-
-	goListJSON struct {
-		Dir         string
-		ImportPath  string
-		Name        string
-		GoFiles     []string
-		TestGoFiles []string
-		Module      goListJSONModule
-	}
-
-	testFunctionFilePos struct {
-		Line int
-		Col  int
-	}
-
-	testFileDetail struct {
-		FileName            string
-		TestFunctionFilePos testFunctionFilePos
-	}
-
-	testFileDetailsByPackage map[string]map[string]*testFileDetail
-)
+type cmdFlags struct {
+	configFlag  string
+	titleFlag   string
+	sizeFlag    string
+	groupSize   int
+	outputFlag  string
+	junitFlag   string
+	jsonOutFlag string
+	failOn      []string
+	historyDir  string
+	historySize int
+	stripANSI   bool
+	verbose     bool
+	// the flags making the report reproducible
+	sortFlag      string
+	timestampFlag string
+	metaFlag      []string
+	// the redaction rules applied to the test output
+	redactPatterns     []string
+	redactKeys         []string
+	redactParams       []string
+	noDefaultRedaction bool
+	// the flags of the diff command
+	baselineFlag      string
+	durationThreshold int
+}
 
 func main() {
 	rootCmd, _, _ := initRootCommand()
 	if err := rootCmd.Execute(); err != nil {
+		var exitErr *exitCodeError
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.code)
+		}
 		os.Exit(1)
 	}
 }
 
-func initRootCommand() (*cobra.Command, *templateData, *cmdFlags) {
+func initRootCommand() (*cobra.Command, *report.Data, *cmdFlags) {
 	flags := &cmdFlags{}
-	tmplData := &templateData{}
+	tmplData := &report.Data{}
 	rootCmd := &cobra.Command{
-		Use:  "go-test-report",
-		Long: "Captures go test output via stdin and parses it into a single self-contained html file.",
-		RunE: func(cmd *cobra.Command, args []string) (e error) {
-			startTime := time.Now()
-			if err := parseSizeFlag(tmplData, flags); err != nil {
+		Use: "go-test-report [files...]",
+		Long: "Captures go test output via stdin and parses it into a single self-contained html file.\n" +
+			"The output can also be read from one or more files (optionally gzip-compressed), \"-\" being stdin.",
+		Args: cobra.ArbitraryArgs,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			fileName, config, err := loadConfigFile(flags.configFlag)
+			if err != nil || fileName == "" {
 				return err
 			}
-			tmplData.numOfTestsPerGroup = flags.groupSize
-			tmplData.ReportTitle = flags.titleFlag
-			tmplData.OutputFilename = flags.outputFlag
-			if err := checkIfStdinIsPiped(); err != nil {
+			return applyConfig(cmd.Root(), fileName, config)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := initTemplateData(tmplData, flags); err != nil {
 				return err
 			}
-			stdin := os.Stdin
-			stdinScanner := bufio.NewScanner(stdin)
-			testReportHTMLTemplateFile, _ := os.Create(tmplData.OutputFilename)
-			reportFileWriter := bufio.NewWriter(testReportHTMLTemplateFile)
-			defer func() {
-				_ = stdin.Close()
-				if err := reportFileWriter.Flush(); err != nil {
-					e = err
+			if len(args) == 0 {
+				if err := checkIfStdinIsPiped(); err != nil {
+					return err
 				}
-				if err := testReportHTMLTemplateFile.Close(); err != nil {
-					e = err
-				}
-			}()
-			startTestTime := time.Now()
-			allPackageNames, allTests, failedTestNames, err := readTestDataFromStdIn(stdinScanner, flags, cmd)
-			_, testsInPackages := formatAllTests(allTests)
-			if err != nil {
-				return errors.New(err.Error() + "\n")
+				args = []string{stdinFileName}
 			}
-			elapsedTestTime := time.Since(startTestTime)
-			// used to the location of test functions in test go files by package and test function name.
-			testFileDetailByPackage, err := getPackageDetails(allPackageNames)
+			input, err := openTestEventFiles(args)
 			if err != nil {
 				return err
 			}
-			//err = generateReport(tmplData, newAllTests, failedTestNames, testFileDetailByPackage, elapsedTestTime, reportFileWriter)
-			err = generateReportV2(tmplData, testsInPackages, failedTestNames, testFileDetailByPackage, elapsedTestTime, reportFileWriter)
-			elapsedTime := time.Since(startTime)
-			elapsedTimeMsg := []byte(fmt.Sprintf("[go-test-report] finished in %s\n", elapsedTime))
-			if _, err := cmd.OutOrStdout().Write(elapsedTimeMsg); err != nil {
+			defer func() {
+				_ = input.Close()
+			}()
+			if err := generateReports(cmd, input, tmplData, flags); err != nil {
 				return err
 			}
-			return nil
+			return checkFailOn(cmd, tmplData, flags)
 		},
 	}
 	versionCmd := &cobra.Command{
@@ -175,6 +102,14 @@ func initRootCommand() (*cobra.Command, *templateData, *cmdFlags) {
 		},
 	}
 	rootCmd.AddCommand(versionCmd)
+	rootCmd.AddCommand(initRunCommand(tmplData, flags))
+	rootCmd.AddCommand(initMergeCommand(tmplData, flags))
+	rootCmd.AddCommand(initDiffCommand(tmplData, flags))
+	rootCmd.AddCommand(initConfigCommand(tmplData, flags))
+	rootCmd.PersistentFlags().StringVar(&flags.configFlag,
+		configFlagName,
+		"",
+		"the configuration file, whose values are overridden by the flags (defaults to "+configFileName+" if it exists)")
 	rootCmd.PersistentFlags().StringVarP(&flags.titleFlag,
 		"title",
 		"t",
@@ -195,6 +130,59 @@ func initRootCommand() (*cobra.Command, *templateData, *cmdFlags) {
 		"o",
 		"test_report.html",
 		"the HTML output file")
+	rootCmd.PersistentFlags().StringVarP(&flags.junitFlag,
+		"junit",
+		"j",
+		"",
+		"the JUnit XML output file (not generated if empty)")
+	rootCmd.PersistentFlags().StringVar(&flags.jsonOutFlag,
+		"json-out",
+		"",
+		"the JSON report output file (not generated if empty)")
+	rootCmd.PersistentFlags().StringSliceVar(&flags.failOn,
+		"fail-on",
+		nil,
+		fmt.Sprintf("exit with a non-zero code on any of the given conditions: %s", strings.Join(failOnConditions, ", ")))
+	rootCmd.PersistentFlags().StringVar(&flags.historyDir,
+		"history-dir",
+		"",
+		"the directory storing the results of previous runs, used to show trends in the report (no history if empty)")
+	rootCmd.PersistentFlags().IntVar(&flags.historySize,
+		"history-size",
+		20,
+		"the number of runs, including the current one, shown in the trends of the report")
+	rootCmd.PersistentFlags().StringVar(&flags.sortFlag,
+		"sort",
+		report.SortAlphabetical,
+		fmt.Sprintf("the order of the packages and of their tests: %s", strings.Join(report.SortOrders, ", ")))
+	rootCmd.PersistentFlags().StringVar(&flags.timestampFlag,
+		"timestamp",
+		"",
+		"the execution date of the report, RFC 3339 or seconds since the Unix epoch, making the report reproducible (defaults to $"+sourceDateEpochEnv+")")
+	rootCmd.PersistentFlags().StringArrayVar(&flags.metaFlag,
+		"meta",
+		nil,
+		"a key=value pair added to the run info of the reports, e.g. --meta env=staging (repeatable)")
+	rootCmd.PersistentFlags().BoolVar(&flags.stripANSI,
+		"strip-ansi",
+		false,
+		"remove the ANSI escape sequences, such as colors, from the test output instead of rendering them")
+	rootCmd.PersistentFlags().StringArrayVar(&flags.redactPatterns,
+		"redact",
+		nil,
+		"a regular expression whose matches are redacted from the test output; only its capture groups are redacted if it has any (repeatable)")
+	rootCmd.PersistentFlags().StringSliceVar(&flags.redactKeys,
+		"redact-key",
+		nil,
+		"the JSON keys and header names, e.g. Authorization, whose values are redacted from the test output")
+	rootCmd.PersistentFlags().StringSliceVar(&flags.redactParams,
+		"redact-param",
+		nil,
+		"the URL query parameters, e.g. txSecret, whose values are redacted from the test output")
+	rootCmd.PersistentFlags().BoolVar(&flags.noDefaultRedaction,
+		"no-default-redaction",
+		false,
+		"only apply the given redaction rules, not the built-in ones (bearer tokens, passwords, API keys...)")
 	rootCmd.PersistentFlags().BoolVarP(&flags.verbose,
 		"verbose",
 		"v",
@@ -204,302 +192,138 @@ func initRootCommand() (*cobra.Command, *templateData, *cmdFlags) {
 	return rootCmd, tmplData, flags
 }
 
-func readTestDataFromStdIn(stdinScanner *bufio.Scanner, flags *cmdFlags, cmd *cobra.Command) (allPackageNames map[string]*types.Nil, allTests map[string]*testStatus, failedTestNames []string, e error) {
-	allTests = map[string]*testStatus{}
-	allPackageNames = map[string]*types.Nil{}
-
-	parentFailedTestNames := []string{}
-	subFailedTestNames := []string{}
-
-	// read from stdin and parse "go test" results
-	for stdinScanner.Scan() {
-		lineInput := stdinScanner.Bytes()
-
-		if flags.verbose {
-			newline := []byte("\n")
-			if _, err := cmd.OutOrStdout().Write(append(lineInput, newline[0])); err != nil {
-				return nil, nil, nil, err
-			}
-		}
-		goTestOutputRow := &goTestOutputRow{}
-		if err := json.Unmarshal(lineInput, goTestOutputRow); err != nil {
-			return nil, nil, nil, err
-		}
-		goTestOutputRow.TestName = filterTestName(goTestOutputRow.TestName)
-
-		if goTestOutputRow.TestName != "" {
-			var status *testStatus
-			key := goTestOutputRow.Package + "." + goTestOutputRow.TestName
-			if _, exists := allTests[key]; !exists {
-				status = &testStatus{
-					TestName: goTestOutputRow.TestName,
-					Package:  goTestOutputRow.Package,
-					Output:   []string{},
-				}
-				allTests[key] = status
-			} else {
-				status = allTests[key]
-			}
-			if goTestOutputRow.Action == "pass" || goTestOutputRow.Action == "fail" || goTestOutputRow.Action == "skip" {
-				isParentTest := !strings.Contains(goTestOutputRow.TestName, "/")
-				if goTestOutputRow.Action == "fail" {
-					if isParentTest {
-						parentFailedTestNames = append(parentFailedTestNames, key)
-					} else {
-						subFailedTestNames = append(subFailedTestNames, key)
-					}
-				}
-
-				if goTestOutputRow.Action == "pass" {
-					status.Passed = true
-					if isParentTest {
-						status.Omitted = true
-					}
-				}
-				if goTestOutputRow.Action == "skip" {
-					status.Skipped = true
-				}
-				status.ElapsedTime = goTestOutputRow.Elapsed
-			}
-			allPackageNames[goTestOutputRow.Package] = nil
-
-			status.Output = append(status.Output, goTestOutputRow.Output)
-		}
+// initTemplateData applies the report related flags to the template data.
+func initTemplateData(tmplData *report.Data, flags *cmdFlags) error {
+	if err := parseSizeFlag(tmplData, flags); err != nil {
+		return err
 	}
-
-	failedTestNames = []string{}
-
-	for _, parentName := range parentFailedTestNames {
-		for _, subName := range subFailedTestNames {
-			if strings.HasPrefix(subName, parentName) {
-				allTests[parentName].Omitted = true
-			}
-		}
-		if allTests[parentName].Omitted != true {
-			failedTestNames = append(failedTestNames, parentName)
-		}
+	tmplData.NumOfTestsPerGroup = flags.groupSize
+	tmplData.ReportTitle = flags.titleFlag
+	tmplData.OutputFilename = flags.outputFlag
+	if flags.historySize < 1 {
+		return errors.New("history-size must be at least 1")
 	}
-
-	failedTestNames = append(failedTestNames, subFailedTestNames...)
-
-	return allPackageNames, allTests, failedTestNames, nil
-}
-
-func getPackageDetails(allPackageNames map[string]*types.Nil) (testFileDetailsByPackage, error) {
-	var out bytes.Buffer
-	var cmd *exec.Cmd
-	testFileDetailByPackage := testFileDetailsByPackage{}
-	stringReader := strings.NewReader("")
-	for packageName := range allPackageNames {
-		cmd = exec.Command("go", "list", "-json", packageName)
-		out.Reset()
-		stringReader.Reset("")
-		cmd.Stdin = stringReader
-		cmd.Stdout = &out
-		err := cmd.Run()
-		if err != nil {
-			return nil, err
-		}
-		goListJSON := &goListJSON{}
-		if err := json.Unmarshal(out.Bytes(), goListJSON); err != nil {
-			return nil, err
-		}
-		testFileDetailByPackage[packageName] = map[string]*testFileDetail{}
-		for _, file := range goListJSON.TestGoFiles {
-			sourceFilePath := fmt.Sprintf("%s/%s", goListJSON.Dir, file)
-			fileSet := token.NewFileSet()
-			f, err := parser.ParseFile(fileSet, sourceFilePath, nil, 0)
-			if err != nil {
-				return nil, err
-			}
-			ast.Inspect(f, func(n ast.Node) bool {
-				switch x := n.(type) {
-				case *ast.FuncDecl:
-					testFileDetail := &testFileDetail{}
-					fileSetPos := fileSet.Position(n.Pos())
-					folders := strings.Split(fileSetPos.String(), "/")
-					fileNameWithPos := folders[len(folders)-1]
-					fileDetails := strings.Split(fileNameWithPos, ":")
-					lineNum, _ := strconv.Atoi(fileDetails[1])
-					colNum, _ := strconv.Atoi(fileDetails[2])
-					testFileDetail.FileName = fileDetails[0]
-					testFileDetail.TestFunctionFilePos = testFunctionFilePos{
-						Line: lineNum,
-						Col:  colNum,
-					}
-					testFileDetailByPackage[packageName][x.Name.Name] = testFileDetail
-				}
-				return true
-			})
-		}
+	if err := validateSortFlag(flags); err != nil {
+		return err
 	}
-	return testFileDetailByPackage, nil
-}
-
-type testRef struct {
-	key  string
-	name string
-}
-type byName []testRef
-
-func (t byName) Len() int {
-	return len(t)
-}
-func (t byName) Swap(i, j int) {
-	t[i], t[j] = t[j], t[i]
-}
-func (t byName) Less(i, j int) bool {
-	return t[i].name < t[j].name
-}
-
-func generateReport(tmplData *templateData, allTests map[string]*testStatus, failedTestNames []string, testFileDetailByPackage testFileDetailsByPackage, elapsedTestTime time.Duration, reportFileWriter *bufio.Writer) error {
-	// read the html template from the generated embedded asset go file
-	tpl := template.New("test_report.html.template")
-	testReportHTMLTemplateStr, err := hex.DecodeString(testReportHTMLTemplate)
+	tmplData.SortOrder = flags.sortFlag
+	reportTime, err := parseReportTime(flags)
 	if err != nil {
 		return err
 	}
-	tpl, err = tpl.Parse(string(testReportHTMLTemplateStr))
+	tmplData.ReportTime = reportTime
+	runInfo, err := collectRunInfo(flags)
 	if err != nil {
 		return err
 	}
-	// read Javascript code from the generated embedded asset go file
-	testReportJsCodeStr, err := hex.DecodeString(testReportJsCode)
+	tmplData.RunInfo = runInfo
+	return validateFailOnFlag(flags)
+}
+
+// generateReports reads the "go test -json" output from input and writes the HTML report, along with the JUnit XML
+// and JSON reports if requested.
+func generateReports(cmd *cobra.Command, input io.Reader, tmplData *report.Data, flags *cmdFlags) error {
+	startTime := time.Now()
+	redactor, err := newRedactor(flags)
 	if err != nil {
 		return err
 	}
+	results, err := report.ParseEvents(input, parseOptions(cmd, flags))
+	if err != nil {
+		return errors.New(err.Error() + "\n")
+	}
+	_, testsInPackages := report.FormatTests(results.Tests, redactor)
+	elapsedTestTime := time.Since(startTime)
+	tmplData.BuildFailedPackages = results.BuildFailedPackages
+	return writeReports(cmd, tmplData, flags, results.PackageNames, testsInPackages, results.FailedTestNames, elapsedTestTime, startTime)
+}
 
-	tmplData.FailedTestNames = failedTestNames
-	tmplData.NumOfTestPassed = 0
-	tmplData.NumOfTestFailed = 0
-	tmplData.NumOfTestSkipped = 0
-	tmplData.JsCode = template.JS(testReportJsCodeStr)
-	tgCounter := 0
-	tgID := 0
-
-	// sort the allTests map by test name (this will produce a consistent order when iterating through the map)
-	var tests []testRef
-	for test, status := range allTests {
-		tests = append(tests, testRef{test, status.TestName})
+// writeReports generates the HTML report from the parsed test results, along with the JUnit XML and JSON reports if
+// requested.
+func writeReports(cmd *cobra.Command, tmplData *report.Data, flags *cmdFlags, allPackageNames map[string]*types.Nil, testsInPackages map[string]map[string]*report.TestStatus, failedTestNames []string, elapsedTestTime time.Duration, startTime time.Time) error {
+	// used to the location of test functions in test go files by package and test function name.
+	testFileDetailByPackage, err := report.ReadTestFileDetails(allPackageNames)
+	if err != nil {
+		return err
 	}
-	sort.Sort(byName(tests))
-	for _, test := range tests {
-		status := allTests[test.key]
-		if len(tmplData.TestResults) == tgID {
-			tmplData.TestResults = append(tmplData.TestResults, &testGroupData{})
-		}
-		// add file info(name and position; line and col) associated with the test function
-		testFileInfo := testFileDetailByPackage[status.Package][status.TestName]
-		if testFileInfo != nil {
-			status.TestFileName = testFileInfo.FileName
-			status.TestFunctionDetail = testFileInfo.TestFunctionFilePos
+	if !tmplData.ReportTime.IsZero() {
+		// unlike the time spent reading the input, the durations of the tests do not change from one report to the next
+		elapsedTestTime = topLevelElapsedTime(testsInPackages)
+	}
+	if flags.historyDir != "" {
+		// the history is ordered by the wall-clock time, the report time is only shown
+		if err := report.UpdateHistory(flags.historyDir, flags.historySize, tmplData, testsInPackages, startTime); err != nil {
+			return err
 		}
-		tmplData.TestResults[tgID].TestResults = append(tmplData.TestResults[tgID].TestResults, status)
-		if !status.Passed {
-			if !status.Skipped {
-				tmplData.TestResults[tgID].FailureIndicator = "failed"
-				if !status.Omitted {
-					tmplData.NumOfTestFailed++
-				}
-			} else {
-				tmplData.TestResults[tgID].SkippedIndicator = "skipped"
-				tmplData.NumOfTestSkipped++
-			}
-		} else {
-			if !status.Omitted {
-				tmplData.NumOfTestPassed++
-			}
+	}
+	report.Build(tmplData, testsInPackages, failedTestNames, testFileDetailByPackage, elapsedTestTime)
+	outputs := []struct {
+		filename string
+		renderer report.Renderer
+	}{
+		{tmplData.OutputFilename, report.RendererFunc(report.RenderHTML)},
+		{flags.junitFlag, report.RendererFunc(report.RenderJUnit)},
+		{flags.jsonOutFlag, report.RendererFunc(report.RenderJSON)},
+	}
+	for _, output := range outputs {
+		if output.filename == "" {
+			// the JUnit XML and JSON reports were not requested
+			continue
 		}
-		tgCounter++
-		if tgCounter == tmplData.numOfTestsPerGroup {
-			tgCounter = 0
-			tgID++
+		renderer := output.renderer
+		err := writeOutputFile(output.filename, func(writer io.Writer) error {
+			return renderer.Render(tmplData, writer)
+		})
+		if err != nil {
+			return err
 		}
 	}
-	tmplData.NumOfTests = tmplData.NumOfTestPassed + tmplData.NumOfTestFailed + tmplData.NumOfTestSkipped
-	tmplData.TestDuration = elapsedTestTime.Round(time.Millisecond)
-	td := time.Now()
-	tmplData.TestExecutionDate = fmt.Sprintf("%s %d, %d %02d:%02d:%02d",
-		td.Month(), td.Day(), td.Year(), td.Hour(), td.Minute(), td.Second())
-	if err := tpl.Execute(reportFileWriter, tmplData); err != nil {
+	elapsedTime := time.Since(startTime)
+	elapsedTimeMsg := []byte(fmt.Sprintf("[go-test-report] finished in %s\n", elapsedTime))
+	if _, err := cmd.OutOrStdout().Write(elapsedTimeMsg); err != nil {
 		return err
 	}
 	return nil
 }
 
-func generateReportV2(tmplData *templateData, testsInPacakges map[string]map[string]*testStatus, failedTestNames []string, testFileDetailByPackage testFileDetailsByPackage, elapsedTestTime time.Duration, reportFileWriter *bufio.Writer) error {
-	// read the html template from the generated embedded asset go file
-	tpl := template.New("test_report.html.template")
-	testReportHTMLTemplateStr, err := hex.DecodeString(testReportHTMLTemplate)
-	if err != nil {
-		return err
+// parseOptions returns the options of the test event parser set by the flags.
+func parseOptions(cmd *cobra.Command, flags *cmdFlags) report.ParseOptions {
+	options := report.ParseOptions{StripANSI: flags.stripANSI}
+	if flags.verbose {
+		options.Echo = cmd.OutOrStdout()
 	}
-	tpl, err = tpl.Parse(string(testReportHTMLTemplateStr))
-	if err != nil {
-		return err
-	}
-	// read Javascript code from the generated embedded asset go file
-	testReportJsCodeStr, err := hex.DecodeString(testReportJsCode)
+	return options
+}
+
+// newRedactor builds the redaction rules of the flags.
+func newRedactor(flags *cmdFlags) (*report.Redactor, error) {
+	return report.NewRedactor(report.RedactionRules{
+		Patterns:   flags.redactPatterns,
+		Keys:       flags.redactKeys,
+		Params:     flags.redactParams,
+		NoDefaults: flags.noDefaultRedaction,
+	})
+}
+
+// writeOutputFile creates (or truncates) the given file and writes its content using generate.
+func writeOutputFile(filename string, generate func(writer io.Writer) error) (e error) {
+	outputFile, err := os.Create(filename)
 	if err != nil {
 		return err
 	}
-
-	tmplData.FailedTestNames = failedTestNames
-	tmplData.NumOfTestPassed = 0
-	tmplData.NumOfTestFailed = 0
-	tmplData.NumOfTestSkipped = 0
-	tmplData.JsCode = template.JS(testReportJsCodeStr)
-	tgID := 0
-
-	// sort the allTests map by test name (this will produce a consistent order when iterating through the map)
-
-	for packageName, allTests := range testsInPacakges {
-		var tests []testRef
-		for test, status := range allTests {
-			tests = append(tests, testRef{test, status.TestName})
+	outputFileWriter := bufio.NewWriter(outputFile)
+	defer func() {
+		if err := outputFileWriter.Flush(); err != nil && e == nil {
+			e = err
 		}
-		sort.Sort(byName(tests))
-		tmplData.TestResults = append(tmplData.TestResults, &testGroupData{})
-		for _, test := range tests {
-			status := allTests[test.key]
-			// add file info(name and position; line and col) associated with the test function
-			testFileInfo := testFileDetailByPackage[status.Package][status.TestName]
-			if testFileInfo != nil {
-				status.TestFileName = testFileInfo.FileName
-				status.TestFunctionDetail = testFileInfo.TestFunctionFilePos
-			}
-			tmplData.TestResults[tgID].TestResults = append(tmplData.TestResults[tgID].TestResults, status)
-			if !status.Passed {
-				if !status.Skipped {
-					tmplData.TestResults[tgID].FailureIndicator = "failed"
-					if !status.Omitted {
-						tmplData.NumOfTestFailed++
-					}
-				} else {
-					tmplData.NumOfTestSkipped++
-				}
-			} else {
-				if !status.Omitted {
-					tmplData.NumOfTestPassed++
-				}
-			}
+		if err := outputFile.Close(); err != nil && e == nil {
+			e = err
 		}
-		tmplData.TestResults[tgID].PackageName = packageName
-		tgID++
-	}
-
-	tmplData.NumOfTests = tmplData.NumOfTestPassed + tmplData.NumOfTestFailed + tmplData.NumOfTestSkipped
-	tmplData.TestDuration = elapsedTestTime.Round(time.Millisecond)
-	td := time.Now()
-	tmplData.TestExecutionDate = fmt.Sprintf("%s %d, %d %02d:%02d:%02d",
-		td.Month(), td.Day(), td.Year(), td.Hour(), td.Minute(), td.Second())
-	if err := tpl.Execute(reportFileWriter, tmplData); err != nil {
-		return err
-	}
-	return nil
+	}()
+	return generate(outputFileWriter)
 }
 
-func parseSizeFlag(tmplData *templateData, flags *cmdFlags) error {
+func parseSizeFlag(tmplData *report.Data, flags *cmdFlags) error {
 	flags.sizeFlag = strings.ToLower(flags.sizeFlag)
 	if !strings.Contains(flags.sizeFlag, "x") {
 		val, err := strconv.Atoi(flags.sizeFlag)
@@ -537,150 +361,3 @@ func checkIfStdinIsPiped() error {
 	}
 	return errors.New("ERROR: missing ≪ stdin ≫ pipe")
 }
-
-type Output struct {
-	isJson  bool
-	line    string
-	jsonObj map[string]interface{}
-}
-type OutputStatus struct {
-	output Output
-	time   time.Time
-}
-
-func (s OutputStatus) Less(other OutputStatus) bool {
-	return s.time.Before(other.time)
-}
-func formatAllTests(allTests map[string]*testStatus) (map[string]*testStatus, map[string]map[string]*testStatus) {
-
-	testsOutputs := make(map[string][]OutputStatus)
-	testTitles := make(map[string]string)
-	for key, status := range allTests {
-		if _, ok := testsOutputs[key]; !ok {
-			testsOutputs[key] = make([]OutputStatus, 0)
-		}
-		outputLine := ""
-		for _, output := range status.Output {
-			outputLine += output
-			if !strings.HasSuffix(outputLine, "\n") {
-				continue
-			} else {
-				jsonStr := strings.TrimSpace(outputLine)
-				var jsonObj map[string]interface{}
-				err := json.Unmarshal([]byte(jsonStr), &jsonObj)
-				o := Output{
-					isJson: false,
-					line:   outputLine,
-				}
-				out := OutputStatus{
-					output: o,
-					time:   time.Now(),
-				}
-				if err != nil {
-					testsOutputs[key] = append(testsOutputs[key], out)
-				} else {
-					testName, foundTest := jsonObj[gunit.Test]
-					packageName, foundPackage := jsonObj[gunit.Package]
-					if foundTest && foundPackage {
-
-						delete(jsonObj, gunit.Test)
-						delete(jsonObj, gunit.Package)
-						logTime := jsonObj["time"].(string)
-
-						t, _ := time.Parse(time.RFC3339, logTime)
-						o = Output{
-							isJson:  true,
-							jsonObj: jsonObj,
-						}
-						out = OutputStatus{
-							output: o,
-							time:   t,
-						}
-						if packageName != "" {
-							newKey := packageName.(string) + "." + filterTestName(testName.(string))
-							if _, ok := testsOutputs[newKey]; !ok {
-								testsOutputs[newKey] = make([]OutputStatus, 0)
-							}
-							testsOutputs[newKey] = append(testsOutputs[newKey], out)
-						} else {
-							testsOutputs[key] = append(testsOutputs[key], out)
-						}
-					} else {
-						testsOutputs[key] = append(testsOutputs[key], out)
-					}
-				}
-				outputLine = ""
-			}
-		}
-	}
-	newAllTests := make(map[string]*testStatus)
-	testsInPackages := make(map[string]map[string]*testStatus)
-
-	genOutputs := func(key string, outputStatus []OutputStatus) []string {
-		var outputs []string
-		for _, item := range outputStatus {
-			if item.output.isJson {
-				l := ""
-				level, foundLevel := item.output.jsonObj["level"].(string)
-				if foundLevel {
-					l = level
-					delete(item.output.jsonObj, "level")
-				}
-				delete(item.output.jsonObj, "time")
-				title, foundTitle := item.output.jsonObj[gunit.Title].(string)
-				if foundTitle {
-					testTitles[key] = title
-				} else {
-					if _, ok := item.output.jsonObj[gunit.RequestApi]; ok {
-						bs, _ := json.MarshalIndent(item.output.jsonObj, "", "    ")
-						outputs = append(outputs, fmt.Sprintf("---\n%s|%s ~ \n%s\n---\n", item.time, l, string(bs)))
-					} else {
-						bs, _ := json.Marshal(item.output.jsonObj)
-						outputs = append(outputs, fmt.Sprintf("%s|%s ~ %s\n", item.time, l, string(bs)))
-					}
-				}
-			} else {
-				outputs = append(outputs, item.output.line)
-			}
-		}
-		return outputs
-	}
-
-	for key, _ := range allTests {
-		if _, ok := testsOutputs[key]; ok {
-			if testsInPackages[allTests[key].Package] == nil {
-				testsInPackages[allTests[key].Package] = make(map[string]*testStatus)
-			}
-			sort.Slice(testsOutputs[key], func(i, j int) bool {
-				return OutputStatus{testsOutputs[key][i].output, testsOutputs[key][i].time}.Less(OutputStatus{testsOutputs[key][j].output, testsOutputs[key][j].time})
-			})
-			outputs := genOutputs(key, testsOutputs[key])
-			if title, ok := testTitles[key]; ok {
-				newKey := fmt.Sprintf("%s(%s)", key, title)
-				newAllTests[newKey] = allTests[key]
-
-				newAllTests[newKey].Output = outputs
-				newAllTests[newKey].TestName = fmt.Sprintf("%s(%s)", newAllTests[newKey].TestName, title)
-				testsInPackages[allTests[key].Package][newKey] = newAllTests[newKey]
-			} else {
-				newAllTests[key] = allTests[key]
-				newAllTests[key].Output = outputs
-				testsInPackages[allTests[key].Package][key] = newAllTests[key]
-			}
-		}
-	}
-	return newAllTests, testsInPackages
-}
-
-func filterTestName(name string) (out string) {
-	out = name
-	parts := strings.Split(name, "/"+gunit.FixtureParallel)
-	if len(parts) == 2 {
-		if parts[1] == "" {
-			out = parts[0]
-		} else {
-			out = parts[0] + parts[1]
-		}
-	}
-	return out
-}
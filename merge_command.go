@@ -0,0 +1,191 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"github.com/bugVanisher/gunit-test-report/report"
+	"github.com/spf13/cobra"
+	"go/types"
+	"io/ioutil"
+	"path/filepath"
+	"time"
+)
+
+// shardResults holds the test results read from a single input file.
+type shardResults struct {
+	allPackageNames     map[string]*types.Nil
+	testsInPackages     map[string]map[string]*report.TestStatus
+	failedTestNames     []string
+	buildFailedPackages []string
+}
+
+func initMergeCommand(tmplData *report.Data, flags *cmdFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "merge [flags] files...",
+		Short: "Merges the results of several test runs into a single report",
+		Long: "Merges the results of several test runs, e.g. from parallel CI shards, into a single report.\n" +
+			"Each file is either \"go test -json\" output (optionally gzip-compressed) or a report exported with --json-out.\n" +
+			"Tests found in several files, e.g. reruns, are reported once with an attempt per file; their result is the one\n" +
+			"of the last file. The file name of each test is shown as its shard.",
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := initTemplateData(tmplData, flags); err != nil {
+				return err
+			}
+			startTime := time.Now()
+			var shards []*shardResults
+			for _, fileName := range args {
+				shard, err := readResultsFile(cmd, fileName, flags)
+				if err != nil {
+					return err
+				}
+				// the shard of a test is the base name of its file
+				for _, tests := range shard.testsInPackages {
+					for _, status := range tests {
+						if status.Shard == "" {
+							status.Shard = filepath.Base(fileName)
+						}
+					}
+				}
+				shards = append(shards, shard)
+			}
+			merged := mergeShardResults(shards)
+			elapsedTestTime := topLevelElapsedTime(merged.testsInPackages)
+			tmplData.BuildFailedPackages = merged.buildFailedPackages
+			err := writeReports(cmd, tmplData, flags, merged.allPackageNames, merged.testsInPackages, merged.failedTestNames, elapsedTestTime, startTime)
+			if err != nil {
+				return err
+			}
+			return checkFailOn(cmd, tmplData, flags)
+		},
+	}
+}
+
+// readResultsFile reads the test results of a "go test -json" output file (optionally gzip-compressed) or of a
+// report exported with --json-out.
+func readResultsFile(cmd *cobra.Command, fileName string, flags *cmdFlags) (*shardResults, error) {
+	input, err := openTestEventFiles([]string{fileName})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = input.Close()
+	}()
+	content, err := ioutil.ReadAll(input)
+	if err != nil {
+		return nil, err
+	}
+	shard := &shardResults{
+		allPackageNames: map[string]*types.Nil{},
+		testsInPackages: map[string]map[string]*report.TestStatus{},
+	}
+	jsonReport, err := report.ReadJSONReport(content)
+	if err != nil {
+		return nil, errors.New(fileName + ": " + err.Error())
+	}
+	if jsonReport != nil {
+		sequence := 0
+		for _, pkg := range jsonReport.Packages {
+			shard.testsInPackages[pkg.Name] = map[string]*report.TestStatus{}
+			for _, test := range pkg.Tests {
+				status := test.TestStatus()
+				// the order of the exported report stands for the order of the run
+				status.Sequence = sequence
+				sequence++
+				shard.testsInPackages[pkg.Name][status.Package+"."+status.TestName] = status
+			}
+		}
+		shard.failedTestNames = jsonReport.FailedTests
+		shard.buildFailedPackages = jsonReport.BuildFailedPackages
+	} else {
+		results, err := report.ParseEvents(bytes.NewReader(content), parseOptions(cmd, flags))
+		if err != nil {
+			return nil, errors.New(fileName + ": " + err.Error())
+		}
+		redactor, err := newRedactor(flags)
+		if err != nil {
+			return nil, err
+		}
+		_, shard.testsInPackages = report.FormatTests(results.Tests, redactor)
+		shard.allPackageNames = results.PackageNames
+		shard.failedTestNames = results.FailedTestNames
+		shard.buildFailedPackages = results.BuildFailedPackages
+	}
+	return shard, nil
+}
+
+// topLevelElapsedTime returns the sum of the durations of the top level tests, i.e. the duration of a test run when
+// it is not known.
+func topLevelElapsedTime(testsInPackages map[string]map[string]*report.TestStatus) time.Duration {
+	// durations are added up as integers, whose sum does not depend on the order of the maps
+	var elapsedTime time.Duration
+	for _, tests := range testsInPackages {
+		for _, status := range tests {
+			if status.IsTopLevel() {
+				elapsedTime += time.Duration(status.ElapsedTime * float64(time.Second))
+			}
+		}
+	}
+	return elapsedTime
+}
+
+// mergeShardResults merges the results of several shards. The runs of a test found in several shards become its
+// attempts, in the order of the shards, and its result is the one of the last shard.
+func mergeShardResults(shards []*shardResults) *shardResults {
+	merged := &shardResults{
+		allPackageNames:     map[string]*types.Nil{},
+		testsInPackages:     map[string]map[string]*report.TestStatus{},
+		failedTestNames:     []string{},
+		buildFailedPackages: []string{},
+	}
+	// failed test names do not include the gunit titles, unlike the keys of testsInPackages
+	mergedTests := map[string]*report.TestStatus{}
+	var failedTestNames []string
+	mergedBuildFailedPackages := map[string]*types.Nil{}
+	// the tests of a shard come after the tests of the previous shards in source order
+	sequenceOffset := 0
+	for _, shard := range shards {
+		shardSize := 0
+		for packageName := range shard.allPackageNames {
+			merged.allPackageNames[packageName] = nil
+		}
+		failedTestNames = append(failedTestNames, shard.failedTestNames...)
+		for packageName, tests := range shard.testsInPackages {
+			if merged.testsInPackages[packageName] == nil {
+				merged.testsInPackages[packageName] = map[string]*report.TestStatus{}
+			}
+			for key, status := range tests {
+				if status.Sequence >= shardSize {
+					shardSize = status.Sequence + 1
+				}
+				status.Sequence += sequenceOffset
+				if earlier, exists := merged.testsInPackages[packageName][key]; exists {
+					status.MergeAttempts(earlier)
+					status.Sequence = earlier.Sequence
+				}
+				merged.testsInPackages[packageName][key] = status
+				mergedTests[status.Package+"."+status.BaseTestName()] = status
+			}
+		}
+		for _, packageName := range shard.buildFailedPackages {
+			if _, exists := mergedBuildFailedPackages[packageName]; !exists {
+				mergedBuildFailedPackages[packageName] = nil
+				merged.buildFailedPackages = append(merged.buildFailedPackages, packageName)
+			}
+		}
+		sequenceOffset += shardSize
+	}
+	// a test that failed in a shard may have passed when it ran again in a later one
+	seenTestNames := map[string]*types.Nil{}
+	for _, key := range failedTestNames {
+		if _, exists := seenTestNames[key]; exists {
+			continue
+		}
+		seenTestNames[key] = nil
+		if status := mergedTests[key]; status != nil && (status.Passed || status.Skipped || status.Omitted) {
+			continue
+		}
+		merged.failedTestNames = append(merged.failedTestNames, key)
+	}
+	return merged
+}
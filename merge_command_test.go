@@ -0,0 +1,121 @@
+package main
+
+import (
+	"bytes"
+	"github.com/bugVanisher/gunit-test-report/report"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMergeCommand(t *testing.T) {
+	assertions := assert.New(t)
+	dir, err := ioutil.TempDir("", "go-test-report")
+	assertions.Nil(err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	shard1 := `{"Time":"2020-07-10T01:24:44.269511-05:00","Action":"run","Package":"foo","Test":"TestFunc1"}
+{"Time":"2020-07-10T01:24:45.270311-05:00","Action":"fail","Package":"foo","Test":"TestFunc1","Elapsed":1}
+{"Time":"2020-07-10T01:24:45.270311-05:00","Action":"run","Package":"foo","Test":"TestFunc2"}
+{"Time":"2020-07-10T01:24:47.270311-05:00","Action":"skip","Package":"foo","Test":"TestFunc2","Elapsed":2}
+`
+	shard2 := `{"Time":"2020-07-10T01:24:44.269511-05:00","Action":"run","Package":"foo","Test":"TestFunc1"}
+{"Time":"2020-07-10T01:24:45.270311-05:00","Action":"fail","Package":"foo","Test":"TestFunc1","Elapsed":1}
+{"Time":"2020-07-10T01:24:44.269511-05:00","Action":"run","Package":"foo","Test":"TestFunc3"}
+{"Time":"2020-07-10T01:24:48.270311-05:00","Action":"fail","Package":"foo","Test":"TestFunc3","Elapsed":4}
+`
+	shardReport := &report.Data{
+		TestResults: []*report.TestGroup{
+			{
+				PackageName: "bar",
+				TestResults: []*report.TestStatus{
+					{
+						TestName:    "TestFunc4(sample title)",
+						Title:       "sample title",
+						Package:     "bar",
+						ElapsedTime: 0.5,
+						Output:      []string{"--- SKIP: TestFunc4 (0.50s)\n"},
+						Skipped:     true,
+					},
+				},
+			},
+		},
+	}
+	var shard3 bytes.Buffer
+	assertions.Nil(report.RenderJSON(shardReport, &shard3))
+	assertions.Nil(ioutil.WriteFile(filepath.Join(dir, "shard1.json"), []byte(shard1), 0644))
+	assertions.Nil(ioutil.WriteFile(filepath.Join(dir, "shard2.json"), []byte(shard2), 0644))
+	assertions.Nil(ioutil.WriteFile(filepath.Join(dir, "shard3.json"), shard3.Bytes(), 0644))
+
+	buffer := bytes.NewBufferString("")
+	rootCmd, tmplData, _ := initRootCommand()
+	rootCmd.SetOut(buffer)
+	rootCmd.SetArgs([]string{"merge", "--output", filepath.Join(dir, "report.html"),
+		filepath.Join(dir, "shard1.json"), filepath.Join(dir, "shard2.json"), filepath.Join(dir, "shard3.json")})
+	rootCmdErr := rootCmd.Execute()
+	assertions.Nil(rootCmdErr)
+	assertions.FileExists(filepath.Join(dir, "report.html"))
+	assertions.Equal(2, tmplData.NumOfTestFailed)
+	assertions.Equal(2, tmplData.NumOfTestSkipped)
+	assertions.Equal(4, tmplData.NumOfTests)
+	assertions.Equal([]string{"foo.TestFunc1", "foo.TestFunc3"}, tmplData.FailedTestNames)
+	assertions.Equal(7500, int(tmplData.TestDuration.Milliseconds()))
+	assertions.Len(tmplData.TestResults, 2)
+
+	shards := map[string]string{}
+	elapsedTimes := map[string]float64{}
+	for _, group := range tmplData.TestResults {
+		elapsedTimes[group.PackageName] = group.ElapsedTime
+		for _, status := range group.TestResults {
+			shards[status.TestName] = status.Shard
+		}
+	}
+	assertions.Equal(map[string]float64{"foo": 7, "bar": 0.5}, elapsedTimes)
+	assertions.Equal(map[string]string{
+		"TestFunc1":               "shard2.json",
+		"TestFunc2":               "shard1.json",
+		"TestFunc3":               "shard2.json",
+		"TestFunc4(sample title)": "shard3.json",
+	}, shards)
+}
+
+func TestMergeShardResultsWithReruns(t *testing.T) {
+	assertions := assert.New(t)
+	newShard := func(func1Passed bool, func2Passed bool, shard string) *shardResults {
+		shardResults := &shardResults{
+			testsInPackages: map[string]map[string]*report.TestStatus{
+				"foo": {
+					"foo.TestFunc1": {TestName: "TestFunc1", Package: "foo", ElapsedTime: 1, Passed: func1Passed, Shard: shard},
+					"foo.TestFunc2": {TestName: "TestFunc2", Package: "foo", ElapsedTime: 2, Passed: func2Passed, Shard: shard, Sequence: 1},
+				},
+			},
+		}
+		if !func1Passed {
+			shardResults.failedTestNames = append(shardResults.failedTestNames, "foo.TestFunc1")
+		}
+		if !func2Passed {
+			shardResults.failedTestNames = append(shardResults.failedTestNames, "foo.TestFunc2")
+		}
+		return shardResults
+	}
+	merged := mergeShardResults([]*shardResults{newShard(false, false, "shard1"), newShard(true, false, "shard2")})
+	assertions.Equal([]string{"foo.TestFunc2"}, merged.failedTestNames)
+
+	func1 := merged.testsInPackages["foo"]["foo.TestFunc1"]
+	assertions.True(func1.Passed)
+	assertions.True(func1.Flaky)
+	assertions.Equal("shard2", func1.Shard)
+	assertions.Equal(0, func1.Sequence)
+	assertions.Len(func1.Attempts, 2)
+	assertions.False(func1.Attempts[0].Passed)
+	assertions.True(func1.Attempts[1].Passed)
+
+	func2 := merged.testsInPackages["foo"]["foo.TestFunc2"]
+	assertions.False(func2.Passed)
+	assertions.False(func2.Flaky)
+	assertions.Equal(1, func2.Sequence)
+	assertions.Len(func2.Attempts, 2)
+}
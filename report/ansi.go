@@ -0,0 +1,12 @@
+package report
+
+import "regexp"
+
+// ansiEscapeRegex matches the escape sequences of a terminal: CSI sequences, e.g. the "\x1b[31m" SGR sequence setting
+// a red foreground, and OSC sequences.
+var ansiEscapeRegex = regexp.MustCompile("\x1b(?:\\[[0-9;?]*[@-~]|\\][^\x07\x1b]*(?:\x07|\x1b\\\\))")
+
+// stripANSI removes the terminal escape sequences, such as colors, from the output of a test.
+func stripANSI(output string) string {
+	return ansiEscapeRegex.ReplaceAllString(output, "")
+}
@@ -0,0 +1,83 @@
+package report
+
+import (
+	"regexp"
+	"strings"
+)
+
+// logPrefixRegex matches the location prefixed by the testing package to the first line of a log message.
+var logPrefixRegex = regexp.MustCompile(`^[\w.-]+\.go:\d+: ?`)
+
+// AssertionDiff holds the expected and actual values of a failed assertion, as printed in the output of a test.
+type AssertionDiff struct {
+	Expected string
+	Actual   string
+}
+
+// parseAssertionDiffs finds the expected and actual values of the failed assertions in the output of a test. Two
+// formats are recognized:
+//
+//	expected: "a"        (testify, e.g. assert.Equal)
+//	actual  : "b"
+//
+//	Expected: 'a'        (gunit So(...) assertions; values may span several lines)
+//	Actual:   'b'
+//	(Should be equal)
+func parseAssertionDiffs(output []string) []*AssertionDiff {
+	var diffs []*AssertionDiff
+	lines := strings.Split(strings.Join(output, ""), "\n")
+	for i, line := range lines {
+		lines[i] = logPrefixRegex.ReplaceAllString(strings.TrimSpace(stripANSI(line)), "")
+	}
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		switch {
+		case strings.HasPrefix(line, "expected: ") && i+1 < len(lines):
+			if value, ok := valueAfterColon(lines[i+1], "actual"); ok {
+				diffs = append(diffs, &AssertionDiff{
+					Expected: strings.TrimPrefix(line, "expected: "),
+					Actual:   value,
+				})
+				i++
+			}
+		case strings.HasPrefix(line, "Expected:"):
+			expected := []string{strings.TrimSpace(strings.TrimPrefix(line, "Expected:"))}
+			j := i + 1
+			for ; j < len(lines) && !strings.HasPrefix(lines[j], "Actual:") && !isAssertionBlockEnd(lines[j]); j++ {
+				expected = append(expected, lines[j])
+			}
+			if j == len(lines) || !strings.HasPrefix(lines[j], "Actual:") {
+				continue
+			}
+			actual := []string{strings.TrimSpace(strings.TrimPrefix(lines[j], "Actual:"))}
+			for j++; j < len(lines) && !isAssertionBlockEnd(lines[j]); j++ {
+				actual = append(actual, lines[j])
+			}
+			diffs = append(diffs, &AssertionDiff{
+				Expected: strings.Join(expected, "\n"),
+				Actual:   strings.Join(actual, "\n"),
+			})
+			i = j - 1
+		}
+	}
+	return diffs
+}
+
+// valueAfterColon returns the value of a "<label> : <value>" line, whatever the padding before the colon.
+func valueAfterColon(line string, label string) (string, bool) {
+	if !strings.HasPrefix(line, label) {
+		return "", false
+	}
+	rest := strings.TrimLeft(strings.TrimPrefix(line, label), " ")
+	if !strings.HasPrefix(rest, ":") {
+		return "", false
+	}
+	return strings.TrimSpace(strings.TrimPrefix(rest, ":")), true
+}
+
+// isAssertionBlockEnd returns true for the (trimmed) lines ending the values of a gunit assertion: the assertion
+// name, e.g. "(Should be equal)", the diff, an empty line or the next assertion.
+func isAssertionBlockEnd(line string) bool {
+	return line == "" || strings.HasPrefix(line, "(") || strings.HasPrefix(line, "Diff:") ||
+		strings.HasPrefix(line, "Expected:") || strings.HasPrefix(line, "---") || strings.HasPrefix(line, "===")
+}
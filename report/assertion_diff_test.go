@@ -0,0 +1,43 @@
+package report
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseAssertionDiffs(t *testing.T) {
+	assertions := assert.New(t)
+	output := []string{
+		"=== RUN   TestFunc1\n",
+		"    sample_test.go:12: \n",
+		"        \tError Trace:\tsample_test.go:12\n",
+		"        \tError:      \tNot equal: \n",
+		"        \t            \texpected: \"foo\"\n",
+		"        \t            \tactual  : \"bar\"\n",
+		"--- FAIL: TestFunc1 (0.00s)\n",
+	}
+	diffs := parseAssertionDiffs(output)
+	assertions.Len(diffs, 1)
+	assertions.Equal(`"foo"`, diffs[0].Expected)
+	assertions.Equal(`"bar"`, diffs[0].Actual)
+
+	output = []string{
+		"=== RUN   TestFixture/TestFunc2\n",
+		"    sample_test.go:24: Expected: 'map[a:1\n",
+		"        b:2]'\n",
+		"        Actual:   'map[a:1\n",
+		"        b:3]'\n",
+		"        (Should be equal)\n",
+		"    sample_test.go:25: Expected: '1'\n",
+		"        Actual:   '2'\n",
+		"--- FAIL: TestFixture/TestFunc2 (0.00s)\n",
+	}
+	diffs = parseAssertionDiffs(output)
+	assertions.Len(diffs, 2)
+	assertions.Equal("'map[a:1\nb:2]'", diffs[0].Expected)
+	assertions.Equal("'map[a:1\nb:3]'", diffs[0].Actual)
+	assertions.Equal("'1'", diffs[1].Expected)
+	assertions.Equal("'2'", diffs[1].Actual)
+
+	assertions.Nil(parseAssertionDiffs([]string{"    sample_test.go:30: expected: nothing else\n"}))
+}
@@ -0,0 +1,104 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Build computes the results of the report from the formatted tests, grouped by package, and from the location of
+// the test functions (see ReadTestFileDetails). The options of tmplData, e.g. its SortOrder, must be set beforehand.
+func Build(tmplData *Data, testsInPackages map[string]map[string]*TestStatus, failedTestNames []string, testFileDetailByPackage TestFileDetails, elapsedTestTime time.Duration) {
+	tmplData.FailedTestNames = failedTestNames
+	tmplData.NumOfTestPassed = 0
+	tmplData.NumOfTestFailed = 0
+	tmplData.NumOfTestSkipped = 0
+	tmplData.NumOfTestInterrupted = 0
+	tmplData.NumOfTestFlaky = 0
+	tmplData.Timelines = buildTimelines(testsInPackages)
+	tmplData.TestResults = nil
+
+	for packageName, allTests := range testsInPackages {
+		var tests []*TestStatus
+		for _, status := range allTests {
+			tests = append(tests, status)
+		}
+		sortTests(tests, tmplData.SortOrder)
+		testGroup := &TestGroup{PackageName: packageName}
+		for _, status := range tests {
+			// add file info(name and position; line and col) associated with the test function
+			testFileInfo := testFileDetailByPackage[status.Package][status.TestName]
+			if testFileInfo != nil {
+				status.TestFileName = testFileInfo.FileName
+				status.TestFunctionDetail = testFileInfo.TestFunctionFilePos
+			}
+			if !status.Passed && !status.Skipped {
+				status.Assertions = parseAssertionDiffs(status.Output)
+			}
+			status.Requests = apiRequests(status.LogEntries)
+			testGroup.TestResults = append(testGroup.TestResults, status)
+			if status.IsTopLevel() {
+				testGroup.ElapsedTime += status.ElapsedTime
+			}
+			if !status.Passed {
+				if !status.Skipped {
+					testGroup.FailureIndicator = "failed"
+					if !status.Omitted {
+						tmplData.NumOfTestFailed++
+						if status.Interrupted {
+							tmplData.NumOfTestInterrupted++
+						}
+					}
+				} else {
+					tmplData.NumOfTestSkipped++
+				}
+			} else {
+				if !status.Omitted {
+					tmplData.NumOfTestPassed++
+				}
+			}
+		}
+		tmplData.NumOfTestFlaky += countFlakyTests(testGroup.TestResults)
+		tmplData.TestResults = append(tmplData.TestResults, testGroup)
+	}
+	sortTestGroups(tmplData.TestResults, tmplData.SortOrder)
+
+	tmplData.NumOfTests = tmplData.NumOfTestPassed + tmplData.NumOfTestFailed + tmplData.NumOfTestSkipped
+	tmplData.TestDuration = elapsedTestTime.Round(time.Millisecond)
+	executionTime := tmplData.ReportTime
+	if executionTime.IsZero() {
+		executionTime = time.Now()
+	}
+	tmplData.TestExecutionDate = formatExecutionDate(executionTime)
+}
+
+// countFlakyTests counts the flaky tests of a package. Parents are not counted along with their flaky subtests, as
+// they are only flaky because of them; unlike in the other counters, passed top level tests are counted.
+func countFlakyTests(tests []*TestStatus) int {
+	var flakyTestNames []string
+	for _, status := range tests {
+		if status.Flaky {
+			flakyTestNames = append(flakyTestNames, status.BaseTestName())
+		}
+	}
+	count := 0
+	for _, name := range flakyTestNames {
+		hasFlakySubtest := false
+		for _, otherName := range flakyTestNames {
+			if strings.HasPrefix(otherName, name+"/") {
+				hasFlakySubtest = true
+				break
+			}
+		}
+		if !hasFlakySubtest {
+			count++
+		}
+	}
+	return count
+}
+
+// formatExecutionDate formats a date as shown in the header of the report.
+func formatExecutionDate(td time.Time) string {
+	return fmt.Sprintf("%s %d, %d %02d:%02d:%02d",
+		td.Month(), td.Day(), td.Year(), td.Hour(), td.Minute(), td.Second())
+}
@@ -0,0 +1,178 @@
+package report
+
+import (
+	"bufio"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestBuild(t *testing.T) {
+	assertions := assert.New(t)
+	tmplData := &Data{
+		TestResultGroupIndicatorWidth:  "20px",
+		TestResultGroupIndicatorHeight: "16px",
+		ReportTitle:                    "test-title",
+		NumOfTestsPerGroup:             2,
+		OutputFilename:                 "test-output-report.html",
+	}
+	allTests := map[string]*TestStatus{}
+	allTests["TestFunc1"] = &TestStatus{
+		TestName:           "TestFunc1",
+		Package:            "go-test-report",
+		ElapsedTime:        0,
+		Output:             nil,
+		Passed:             false,
+		Omitted:            true,
+		TestFileName:       "",
+		TestFunctionDetail: TestFunctionFilePos{},
+	}
+	allTests["TestFunc2"] = &TestStatus{
+		TestName:     "TestFunc2",
+		Package:      "package2",
+		ElapsedTime:  0,
+		Output:       nil,
+		Passed:       true,
+		TestFileName: "",
+
+		TestFunctionDetail: TestFunctionFilePos{},
+	}
+	allTests["TestFunc3"] = &TestStatus{
+		TestName:           "TestFunc3",
+		Package:            "package2",
+		ElapsedTime:        0,
+		Output:             nil,
+		Passed:             true,
+		TestFileName:       "",
+		TestFunctionDetail: TestFunctionFilePos{},
+	}
+	allTests["TestFunc4"] = &TestStatus{
+		TestName:           "TestFunc4",
+		Package:            "go-test-report",
+		ElapsedTime:        0,
+		Output:             nil,
+		Passed:             false,
+		Skipped:            true,
+		TestFileName:       "",
+		TestFunctionDetail: TestFunctionFilePos{},
+	}
+	testsInPackages := make(map[string]map[string]*TestStatus)
+	testsInPackages["package2"] = make(map[string]*TestStatus)
+	testsInPackages["package2"]["TestFunc3"] = allTests["TestFunc3"]
+	testsInPackages["package2"]["TestFunc2"] = allTests["TestFunc2"]
+	testsInPackages["go-test-report"] = make(map[string]*TestStatus)
+	testsInPackages["go-test-report"]["TestFunc1"] = allTests["TestFunc1"]
+	testsInPackages["go-test-report"]["TestFunc4"] = allTests["TestFunc4"]
+
+	testFileDetails := TestFileDetails{}
+	testFileDetails["go-test-report"] = map[string]*TestFileDetail{}
+	testFileDetails["go-test-report"]["TestFunc1"] = &TestFileDetail{
+		FileName: "sample_file_1.go",
+		TestFunctionFilePos: TestFunctionFilePos{
+			Line: 101,
+			Col:  1,
+		},
+	}
+	testFileDetails["package2"] = map[string]*TestFileDetail{}
+	testFileDetails["package2"]["TestFunc2"] = &TestFileDetail{
+		FileName: "sample_file_2.go",
+		TestFunctionFilePos: TestFunctionFilePos{
+			Line: 784,
+			Col:  17,
+		},
+	}
+	testFileDetails["package2"]["TestFunc3"] = &TestFileDetail{
+		FileName: "sample_file_3.go",
+		TestFunctionFilePos: TestFunctionFilePos{
+			Line: 99,
+			Col:  17,
+		},
+	}
+	testFileDetails["go-test-report"]["TestFunc4"] = &TestFileDetail{
+		TestFunctionFilePos: TestFunctionFilePos{
+			Line: 0,
+			Col:  0,
+		},
+	}
+	elapsedTestTime := 3 * time.Second
+	testReportHTMLTemplateFile, err := ioutil.TempFile("", "test-*.html")
+	assertions.Nil(err)
+	reportFileWriter := bufio.NewWriter(testReportHTMLTemplateFile)
+	defer func() {
+		assertions.Nil(reportFileWriter.Flush())
+		assertions.Nil(testReportHTMLTemplateFile.Close())
+		_ = os.Remove(testReportHTMLTemplateFile.Name())
+	}()
+	Build(tmplData, testsInPackages, nil, testFileDetails, elapsedTestTime)
+	err = RenderHTML(tmplData, reportFileWriter)
+	assertions.Nil(err)
+	// the omitted parent TestFunc1 is not counted
+	assertions.Equal(2, tmplData.NumOfTestPassed)
+	assertions.Equal(0, tmplData.NumOfTestFailed)
+	assertions.Equal(1, tmplData.NumOfTestSkipped)
+	assertions.Equal(3, tmplData.NumOfTests)
+	assertions.Equal(3*time.Second, tmplData.TestDuration)
+
+	// packages and tests are sorted alphabetically by default
+	assertions.Len(tmplData.TestResults, 2)
+	assertions.Equal("go-test-report", tmplData.TestResults[0].PackageName)
+	assertions.Equal("failed", tmplData.TestResults[0].FailureIndicator)
+	assertions.Len(tmplData.TestResults[0].TestResults, 2)
+	assertions.Equal("TestFunc1", tmplData.TestResults[0].TestResults[0].TestName)
+	assertions.Equal(false, tmplData.TestResults[0].TestResults[0].Passed)
+	assertions.Equal("sample_file_1.go", tmplData.TestResults[0].TestResults[0].TestFileName)
+	assertions.Equal(1, tmplData.TestResults[0].TestResults[0].TestFunctionDetail.Col)
+	assertions.Equal(101, tmplData.TestResults[0].TestResults[0].TestFunctionDetail.Line)
+
+	assertions.Equal("TestFunc4", tmplData.TestResults[0].TestResults[1].TestName)
+	assertions.Equal(true, tmplData.TestResults[0].TestResults[1].Skipped)
+	assertions.Empty(tmplData.TestResults[0].TestResults[1].TestFileName)
+	assertions.Equal(0, tmplData.TestResults[0].TestResults[1].TestFunctionDetail.Col)
+	assertions.Equal(0, tmplData.TestResults[0].TestResults[1].TestFunctionDetail.Line)
+
+	assertions.Equal("package2", tmplData.TestResults[1].PackageName)
+	assertions.Empty(tmplData.TestResults[1].FailureIndicator)
+	assertions.Len(tmplData.TestResults[1].TestResults, 2)
+	assertions.Equal("TestFunc2", tmplData.TestResults[1].TestResults[0].TestName)
+	assertions.Equal(true, tmplData.TestResults[1].TestResults[0].Passed)
+	assertions.Equal("sample_file_2.go", tmplData.TestResults[1].TestResults[0].TestFileName)
+	assertions.Equal(17, tmplData.TestResults[1].TestResults[0].TestFunctionDetail.Col)
+	assertions.Equal(784, tmplData.TestResults[1].TestResults[0].TestFunctionDetail.Line)
+
+	assertions.Equal("TestFunc3", tmplData.TestResults[1].TestResults[1].TestName)
+	assertions.Equal(true, tmplData.TestResults[1].TestResults[1].Passed)
+	assertions.Equal("sample_file_3.go", tmplData.TestResults[1].TestResults[1].TestFileName)
+	assertions.Equal(17, tmplData.TestResults[1].TestResults[1].TestFunctionDetail.Col)
+	assertions.Equal(99, tmplData.TestResults[1].TestResults[1].TestFunctionDetail.Line)
+}
+
+func TestCountFlakyTests(t *testing.T) {
+	assertions := assert.New(t)
+	tests := []*TestStatus{
+		{TestName: "TestFunc1", Passed: true, Omitted: true, Flaky: true},
+		{TestName: "TestFunc1/Sub1", Passed: true, Flaky: true},
+		{TestName: "TestFunc1/Sub2", Passed: true},
+		{TestName: "TestFunc2(sample title)", Title: "sample title", Flaky: true},
+		{TestName: "TestFunc3", Passed: true},
+	}
+	assertions.Equal(2, countFlakyTests(tests))
+}
+
+func TestBuildTwice(t *testing.T) {
+	assertions := assert.New(t)
+	testsInPackages := map[string]map[string]*TestStatus{
+		"foo": {"TestFunc1": {TestName: "TestFunc1", Package: "foo", Passed: true}},
+		"bar": {"TestFunc2": {TestName: "TestFunc2", Package: "bar", Passed: false}},
+	}
+	tmplData := &Data{}
+	Build(tmplData, testsInPackages, nil, TestFileDetails{}, time.Second)
+	Build(tmplData, testsInPackages, nil, TestFileDetails{}, time.Second)
+	assertions.Len(tmplData.TestResults, 2)
+	for _, testGroup := range tmplData.TestResults {
+		assertions.Len(testGroup.TestResults, 1)
+	}
+	assertions.Equal(1, tmplData.NumOfTestPassed)
+	assertions.Equal(1, tmplData.NumOfTestFailed)
+}
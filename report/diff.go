@@ -0,0 +1,248 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+)
+
+// minDurationRegression is the minimum increase of the duration of a test, in seconds, reported as a regression;
+// smaller differences are noise whatever their ratio.
+const minDurationRegression = 0.1
+
+type (
+	// Diff is the comparison of the current run against a baseline, shown at the top of the report.
+	Diff struct {
+		// Baseline is the name of the baseline file.
+		Baseline string
+		// DurationThreshold is the minimum increase of the duration of a test, in percent, reported as a regression.
+		DurationThreshold int
+		// NewFailures holds the tests failing now that did not fail in the baseline.
+		NewFailures []*DiffTest
+		// Fixed holds the tests that failed in the baseline and do not fail anymore.
+		Fixed []*DiffTest
+		// Added holds the tests missing from the baseline.
+		Added []*DiffTest
+		// Removed holds the tests of the baseline that did not run.
+		Removed []*DiffTest
+		// Renamed holds the tests whose gunit title changed since the baseline. They are matched with their baseline
+		// by test name, and compared with it like any other test.
+		Renamed []*DiffTest
+		// Slower holds the tests whose duration regressed beyond the threshold, the largest increase first.
+		Slower []*DiffTest
+	}
+
+	// DiffTest is a test of one of the categories of a Diff, with its result in the current run and in the baseline.
+	DiffTest struct {
+		Package  string
+		TestName string
+		// Status is "passed", "failed" or "skipped", or "" for a removed test.
+		Status string
+		// BaselineStatus is "passed", "failed" or "skipped", or "" for an added test.
+		BaselineStatus string
+		// BaselineTestName is the name of the test in the baseline, including its former gunit title, for a renamed
+		// test.
+		BaselineTestName string
+		// ElapsedTime and BaselineElapsed are the durations of the test in seconds.
+		ElapsedTime     float64
+		BaselineElapsed float64
+	}
+
+	// BaselineTest is a test of the baseline, read from a JSON report or a history run.
+	BaselineTest struct {
+		Package  string
+		TestName string
+		// Title is the gunit title of the test, if any, which TestName ends with.
+		Title          string
+		Status         string
+		Omitted        bool
+		ElapsedSeconds float64
+	}
+)
+
+// ReadBaseline reads the tests of a JSON report, a history run or the latest run of a history directory, by
+// "<package>.<test>" key.
+func ReadBaseline(fileName string) (map[string]*BaselineTest, error) {
+	if info, err := os.Stat(fileName); err == nil && info.IsDir() {
+		runs, err := readHistory(fileName)
+		if err != nil {
+			return nil, err
+		}
+		if len(runs) == 0 {
+			return nil, fmt.Errorf("%s: no run found in the history directory", fileName)
+		}
+		return historyRunBaseline(runs[len(runs)-1]), nil
+	}
+	content, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	// unlike JSON reports, history runs have top level tests
+	run := &historyRun{}
+	if err := json.Unmarshal(content, run); err == nil && run.SchemaVersion == historySchemaVersion && run.Tests != nil {
+		return historyRunBaseline(run), nil
+	}
+	report, err := ReadJSONReport(content)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", fileName, err)
+	}
+	if report == nil {
+		return nil, fmt.Errorf("%s: not a JSON report or history run", fileName)
+	}
+	baseline := map[string]*BaselineTest{}
+	for _, pkg := range report.Packages {
+		for _, test := range pkg.Tests {
+			status := test.TestStatus()
+			baseline[status.Package+"."+status.TestName] = &BaselineTest{
+				Package:        status.Package,
+				TestName:       status.TestName,
+				Title:          status.Title,
+				Status:         test.Status,
+				Omitted:        test.Omitted,
+				ElapsedSeconds: test.ElapsedSeconds,
+			}
+		}
+	}
+	return baseline, nil
+}
+
+func historyRunBaseline(run *historyRun) map[string]*BaselineTest {
+	baseline := map[string]*BaselineTest{}
+	for _, test := range run.Tests {
+		baseline[test.key()] = &BaselineTest{
+			Package:        test.Package,
+			TestName:       strings.TrimPrefix(test.key(), test.Package+"."),
+			Title:          test.Title,
+			Status:         test.Status,
+			Omitted:        test.Omitted,
+			ElapsedSeconds: test.ElapsedSeconds,
+		}
+	}
+	return baseline
+}
+
+// DiffTestResults compares the current test results against the baseline. As in the counters of the report,
+// parents failing because of their subtests are not reported as failures of their own. A test whose gunit title
+// changed is matched with its baseline by test name and reported as renamed, rather than as added and removed.
+func DiffTestResults(baseline map[string]*BaselineTest, testsInPackages map[string]map[string]*TestStatus, durationThreshold int) *Diff {
+	diff := &Diff{DurationThreshold: durationThreshold}
+	currentKeys := map[string]*types.Nil{}
+	for _, tests := range testsInPackages {
+		for key := range tests {
+			currentKeys[key] = nil
+		}
+	}
+	// the baseline tests that no current test matches by key, by "<package>.<test>" key without the gunit title
+	unmatchedBaseline := map[string][]string{}
+	for key, base := range baseline {
+		if _, exists := currentKeys[key]; !exists {
+			unmatchedBaseline[base.baseKey()] = append(unmatchedBaseline[base.baseKey()], key)
+		}
+	}
+	matchedKeys := map[string]*types.Nil{}
+	for _, tests := range testsInPackages {
+		for key, status := range tests {
+			test := &DiffTest{
+				Package:     status.Package,
+				TestName:    status.TestName,
+				Status:      jsonReportStatus(status.Passed, status.Skipped),
+				ElapsedTime: status.ElapsedTime,
+			}
+			base, exists := baseline[key]
+			if !exists {
+				// the same test under another title, unless the match is ambiguous
+				baseKeys := unmatchedBaseline[status.Package+"."+status.BaseTestName()]
+				if len(baseKeys) != 1 {
+					diff.Added = append(diff.Added, test)
+					continue
+				}
+				delete(unmatchedBaseline, status.Package+"."+status.BaseTestName())
+				key = baseKeys[0]
+				base = baseline[key]
+				test.BaselineTestName = base.TestName
+				diff.Renamed = append(diff.Renamed, test)
+			}
+			matchedKeys[key] = nil
+			test.BaselineStatus = base.Status
+			test.BaselineElapsed = base.ElapsedSeconds
+			failed := test.Status == jsonReportStatusFailed && !status.Omitted
+			baselineFailed := base.Status == jsonReportStatusFailed && !base.Omitted
+			if failed && !baselineFailed {
+				diff.NewFailures = append(diff.NewFailures, test)
+			} else if baselineFailed && test.Status != jsonReportStatusFailed {
+				diff.Fixed = append(diff.Fixed, test)
+			}
+			if test.Status != jsonReportStatusSkipped && base.Status != jsonReportStatusSkipped &&
+				test.ElapsedTime-base.ElapsedSeconds >= minDurationRegression &&
+				test.ElapsedTime > base.ElapsedSeconds*(1+float64(durationThreshold)/100) {
+				diff.Slower = append(diff.Slower, test)
+			}
+		}
+	}
+	for key, base := range baseline {
+		if _, exists := matchedKeys[key]; !exists {
+			diff.Removed = append(diff.Removed, &DiffTest{
+				Package:         base.Package,
+				TestName:        base.TestName,
+				BaselineStatus:  base.Status,
+				BaselineElapsed: base.ElapsedSeconds,
+			})
+		}
+	}
+	for _, tests := range [][]*DiffTest{diff.NewFailures, diff.Fixed, diff.Added, diff.Removed, diff.Renamed} {
+		sort.Slice(tests, func(i, j int) bool {
+			if tests[i].Package != tests[j].Package {
+				return tests[i].Package < tests[j].Package
+			}
+			return tests[i].TestName < tests[j].TestName
+		})
+	}
+	sort.SliceStable(diff.Slower, func(i, j int) bool {
+		return diff.Slower[i].ElapsedTime-diff.Slower[i].BaselineElapsed > diff.Slower[j].ElapsedTime-diff.Slower[j].BaselineElapsed
+	})
+	return diff
+}
+
+// Summary returns the differences as printed by the diff command.
+func (diff *Diff) Summary() string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("[go-test-report] compared with %s: %d new failure(s), %d fixed, %d added, %d removed, %d renamed, %d slower\n",
+		diff.Baseline, len(diff.NewFailures), len(diff.Fixed), len(diff.Added), len(diff.Removed), len(diff.Renamed), len(diff.Slower)))
+	for _, test := range diff.NewFailures {
+		builder.WriteString(fmt.Sprintf("  new failure: %s.%s\n", test.Package, test.TestName))
+	}
+	for _, test := range diff.Fixed {
+		builder.WriteString(fmt.Sprintf("  fixed:       %s.%s\n", test.Package, test.TestName))
+	}
+	for _, test := range diff.Added {
+		builder.WriteString(fmt.Sprintf("  added:       %s.%s (%s)\n", test.Package, test.TestName, test.Status))
+	}
+	for _, test := range diff.Removed {
+		builder.WriteString(fmt.Sprintf("  removed:     %s.%s\n", test.Package, test.TestName))
+	}
+	for _, test := range diff.Renamed {
+		builder.WriteString(fmt.Sprintf("  renamed:     %s.%s -> %s\n", test.Package, test.BaselineTestName, test.TestName))
+	}
+	for _, test := range diff.Slower {
+		builder.WriteString(fmt.Sprintf("  slower:      %s.%s %.3fs -> %.3fs (+%.0f%%)\n", test.Package, test.TestName,
+			test.BaselineElapsed, test.ElapsedTime, test.Increase()))
+	}
+	return builder.String()
+}
+
+// baseKey returns the "<package>.<test>" key of the test without its gunit title.
+func (base *BaselineTest) baseKey() string {
+	return base.Package + "." + strings.TrimSuffix(base.TestName, fmt.Sprintf("(%s)", base.Title))
+}
+
+// Increase returns the increase of the duration of a test, in percent.
+func (test *DiffTest) Increase() float64 {
+	if test.BaselineElapsed == 0 {
+		return 0
+	}
+	return 100 * (test.ElapsedTime - test.BaselineElapsed) / test.BaselineElapsed
+}
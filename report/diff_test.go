@@ -0,0 +1,67 @@
+package report
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestReadBaselineFromHistory(t *testing.T) {
+	assertions := assert.New(t)
+	dir, err := ioutil.TempDir("", "go-test-report")
+	assertions.Nil(err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	runTime := time.Date(2020, time.July, 10, 1, 24, 44, 0, time.UTC)
+	for i, passed := range []bool{false, true} {
+		testsInPackages := map[string]map[string]*TestStatus{
+			"foo": {
+				"foo.TestFunc1(sample title)": {
+					TestName: "TestFunc1(sample title)",
+					Title:    "sample title",
+					Package:  "foo",
+					Passed:   passed,
+				},
+			},
+		}
+		currentRunTime := runTime.Add(time.Duration(i) * time.Hour)
+		assertions.Nil(writeHistoryRun(dir, newHistoryRun(testsInPackages, currentRunTime, ""), currentRunTime, i+1))
+	}
+
+	baseline, err := ReadBaseline(dir)
+	assertions.Nil(err)
+	assertions.Len(baseline, 1)
+	assertions.Equal("TestFunc1(sample title)", baseline["foo.TestFunc1(sample title)"].TestName)
+	assertions.Equal(jsonReportStatusPassed, baseline["foo.TestFunc1(sample title)"].Status)
+
+	baseline, err = ReadBaseline(filepath.Join(dir, "run-20200710T012444.000000000Z-000001.json"))
+	assertions.Nil(err)
+	assertions.Equal(jsonReportStatusFailed, baseline["foo.TestFunc1(sample title)"].Status)
+}
+
+func TestDiffTestResultsWithRenamedTests(t *testing.T) {
+	assertions := assert.New(t)
+	baseline := map[string]*BaselineTest{
+		"foo.TestFunc1(old title)": {Package: "foo", TestName: "TestFunc1(old title)", Title: "old title", Status: jsonReportStatusFailed, ElapsedSeconds: 1},
+		"foo.TestFunc2":            {Package: "foo", TestName: "TestFunc2", Status: jsonReportStatusPassed, ElapsedSeconds: 1},
+	}
+	testsInPackages := map[string]map[string]*TestStatus{
+		"foo": {
+			"foo.TestFunc1(new title)": {TestName: "TestFunc1(new title)", Title: "new title", Package: "foo", ElapsedTime: 1, Passed: true},
+			"foo.TestFunc2":            {TestName: "TestFunc2", Package: "foo", ElapsedTime: 1, Passed: true},
+		},
+	}
+	diff := DiffTestResults(baseline, testsInPackages, 20)
+	assertions.Empty(diff.Added)
+	assertions.Empty(diff.Removed)
+	assertions.Len(diff.Renamed, 1)
+	assertions.Equal("TestFunc1(new title)", diff.Renamed[0].TestName)
+	assertions.Equal("TestFunc1(old title)", diff.Renamed[0].BaselineTestName)
+	assertions.Len(diff.Fixed, 1)
+	assertions.Equal("TestFunc1(new title)", diff.Fixed[0].TestName)
+	assertions.Contains(diff.Summary(), "  renamed:     foo.TestFunc1(old title) -> TestFunc1(new title)\n")
+}
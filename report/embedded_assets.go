@@ -0,0 +1,5 @@
+package report
+
+var testReportHTMLTemplate = `3c21444f43545950452068746d6c3e0a3c68746d6c206c616e673d22656e223e0a3c686561643e0a202020203c6d65746120636861727365743d225554462d38223e0a202020203c7469746c653e7b7b2e5265706f72745469746c657d7d3c2f7469746c653e0a202020203c7374796c6520747970653d22746578742f637373223e0a2020202020202020626f6479207b0a202020202020202020202020666f6e742d66616d696c793a2073616e732d73657269663b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236633663366333b0a202020202020202020202020626f726465722d746f703a20327078202364656536653820736f6c69643b0a2020202020202020202020206d617267696e3a20303b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572207370616e2e70726f6a6563745469746c65207b0a202020202020202020202020666f6e742d66616d696c793a2073657269663b0a202020202020202020202020666f6e742d73697a653a2032656d3b0a20202020202020202020202070616464696e672d6c6566743a20353670783b0a20202020202020202020202070616464696e672d746f703a20383070783b0a202020202020202020202020646973706c61793a20626c6f636b3b0a202020202020202020202020636f6c6f723a20236135613561353b0a202020202020202020202020746578742d736861646f773a2030202d317078203170782077686974653b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207b0a202020202020202020202020706f736974696f6e3a206162736f6c7574653b0a202020202020202020202020746f703a203770783b0a20202020202020202020202072696768743a20353270783b0a202020202020202020202020666f6e742d73697a653a20302e38656d3b0a202020202020202020202020636f6c6f723a20236132613261323b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207370616e2e696e64696361746f72207b0a202020202020202020202020666f6e742d73697a653a2032656d3b0a202020202020202020202020706f736974696f6e3a2072656c61746976653b0a202020202020202020202020746f703a203570783b0a202020202020202020202020746578742d736861646f773a20302031707820302077686974653b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207370616e207374726f6e67207b0a2020202020202020202020206d617267696e2d72696768743a20313670783b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207370616e2e746f74616c207b0a202020202020202020202020626f726465722d72696768743a20317078202361666166616620646f747465643b0a2020202020202020202020206261636b67726f756e643a20233832393861663b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207370616e2e706173736564207b0a202020202020202020202020626f726465722d72696768743a20317078202361666166616620646f747465643b0a2020202020202020202020206261636b67726f756e643a20233666636138333b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207370616e2e736b6970706564207b0a2020202020202020202020206261636b67726f756e643a20236261626162613b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207370616e2e6661696c6564207b0a2020202020202020202020206261636b67726f756e643a20236666373637363b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207370616e2e666c616b79207b0a202020202020202020202020626f726465722d6c6566743a20317078202361666166616620646f747465643b0a2020202020202020202020206261636b67726f756e643a20236234386164313b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207370616e2e696e746572727570746564207b0a202020202020202020202020626f726465722d6c6566743a20317078202361666166616620646f747465643b0a2020202020202020202020206261636b67726f756e643a20236666613034643b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572206469762e746573745374617473207370616e207b0a2020202020202020202020206d617267696e2d72696768743a203170783b0a2020202020202020202020206865696768743a20353570783b0a20202020202020202020202070616464696e673a20323070782038707820313870783b0a202020202020202020202020636f6c6f723a2077686974653b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572202e7465737447726f7570735469746c65207b0a2020202020202020202020206d617267696e3a203136707820333270782038707820343070783b0a202020202020202020202020666f6e742d73697a653a20302e39656d3b0a202020202020202020202020636f6c6f723a206461726b677265793b0a202020202020202020202020646973706c61793a20696e6c696e652d626c6f636b3b0a20202020202020207d0a0a20202020202020206469762e70616765486561646572202e74657374457865637574696f6e44617465207b0a202020202020202020202020646973706c61793a20696e6c696e652d626c6f636b3b0a202020202020202020202020706f736974696f6e3a206162736f6c7574653b0a20202020202020202020202072696768743a20313070783b0a2020202020202020202020206d617267696e3a203134707820333270782038707820343070783b0a202020202020202020202020636f6c6f723a20233965396539653b0a202020202020202020202020666f6e742d73697a653a20302e39656d3b0a20202020202020207d0a0a20202020202020202e746573745265706f7274436f6e7461696e6572207b0a20202020202020202020202070616464696e673a20302033327078203332707820333270783b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572207b0a20202020202020202020202070616464696e673a2031367078203136707820313670783b0a202020202020202020202020626f782d736861646f773a2030203470782034707820236434643464343b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a2077686974653b0a20202020202020207d0a0a20202020202020202374657374526573756c7473207b0a202020202020202020202020646973706c61793a20666c65783b0a202020202020202020202020666c65782d777261703a20777261703b0a20202020202020207d0a0a20202020202020202e7472656e64207b0a2020202020202020202020206d617267696e2d626f74746f6d3a20313670783b0a202020202020202020202020666f6e742d73697a653a20302e38656d3b0a202020202020202020202020636f6c6f723a20233532353235323b0a20202020202020207d0a0a20202020202020202e72756e496e666f207b0a2020202020202020202020206d617267696e2d626f74746f6d3a20313670783b0a202020202020202020202020666f6e742d73697a653a20302e38656d3b0a202020202020202020202020636f6c6f723a20233532353235323b0a20202020202020207d0a0a20202020202020202e72756e496e666f2073756d6d617279207b0a202020202020202020202020637572736f723a20706f696e7465723b0a202020202020202020202020636f6c6f723a206461726b677265793b0a202020202020202020202020666f6e742d73697a653a20312e31656d3b0a20202020202020207d0a0a20202020202020202e72756e496e666f207461626c65207b0a2020202020202020202020206d617267696e2d746f703a203870783b0a202020202020202020202020626f726465722d636f6c6c617073653a20636f6c6c617073653b0a20202020202020207d0a0a20202020202020202e72756e496e666f207464207b0a202020202020202020202020746578742d616c69676e3a206c6566743b0a20202020202020202020202070616464696e673a2034707820313670782034707820303b0a202020202020202020202020626f726465722d626f74746f6d3a20317078202364616461646120646f747465643b0a202020202020202020202020666f6e742d66616d696c793a206d6f6e6f73706163653b0a202020202020202020202020776f72642d627265616b3a20627265616b2d616c6c3b0a20202020202020207d0a0a20202020202020202e74696d656c696e65207b0a2020202020202020202020206d617267696e2d626f74746f6d3a20313670783b0a202020202020202020202020666f6e742d73697a653a20302e38656d3b0a202020202020202020202020636f6c6f723a20233532353235323b0a20202020202020207d0a0a20202020202020202e74696d656c696e65202e74696d656c696e655469746c65207b0a202020202020202020202020646973706c61793a20626c6f636b3b0a2020202020202020202020206d617267696e2d626f74746f6d3a203870783b0a202020202020202020202020636f6c6f723a206461726b677265793b0a202020202020202020202020666f6e742d73697a653a20312e31656d3b0a20202020202020207d0a0a20202020202020202e74696d656c696e652064657461696c732e7061636b61676554696d656c696e652073756d6d617279207b0a202020202020202020202020637572736f723a20706f696e7465723b0a2020202020202020202020206d617267696e2d626f74746f6d3a203470783b0a20202020202020207d0a0a20202020202020202e74696d656c696e65202e74696d656c696e65526f77207b0a202020202020202020202020646973706c61793a20666c65783b0a202020202020202020202020616c69676e2d6974656d733a2063656e7465723b0a2020202020202020202020206865696768743a20313670783b0a20202020202020207d0a0a20202020202020202e74696d656c696e65202e74696d656c696e654c6162656c207b0a20202020202020202020202077696474683a203330253b0a20202020202020202020202070616464696e672d72696768743a203870783b0a2020202020202020202020206f766572666c6f773a2068696464656e3b0a202020202020202020202020746578742d6f766572666c6f773a20656c6c69707369733b0a20202020202020202020202077686974652d73706163653a206e6f777261703b0a202020202020202020202020666f6e742d66616d696c793a206d6f6e6f73706163653b0a20202020202020207d0a0a20202020202020202e74696d656c696e65202e74696d656c696e65547261636b207b0a202020202020202020202020706f736974696f6e3a2072656c61746976653b0a202020202020202020202020666c65783a20313b0a2020202020202020202020206865696768743a20313070783b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236630663066303b0a20202020202020207d0a0a20202020202020202e74696d656c696e65202e74696d656c696e65426172207b0a202020202020202020202020706f736974696f6e3a206162736f6c7574653b0a202020202020202020202020746f703a20303b0a2020202020202020202020206865696768743a20313030253b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20233433633134333b0a20202020202020207d0a0a20202020202020202e74696d656c696e65202e74696d656c696e65526f772e6661696c6564202e74696d656c696e65426172207b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236532346234623b0a20202020202020207d0a0a20202020202020202e74696d656c696e65202e74696d656c696e65526f772e736b6970706564202e74696d656c696e65426172207b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236233623362333b0a20202020202020207d0a0a20202020202020202e7472656e64202e7472656e645469746c65207b0a202020202020202020202020646973706c61793a20626c6f636b3b0a2020202020202020202020206d617267696e2d626f74746f6d3a203870783b0a202020202020202020202020636f6c6f723a206461726b677265793b0a202020202020202020202020666f6e742d73697a653a20312e31656d3b0a20202020202020207d0a0a20202020202020202e7472656e64202e70617373526174654368617274207b0a202020202020202020202020646973706c61793a20666c65783b0a202020202020202020202020616c69676e2d6974656d733a20666c65782d656e643b0a2020202020202020202020206865696768743a20363070783b0a2020202020202020202020206d617267696e2d626f74746f6d3a203870783b0a20202020202020207d0a0a20202020202020202e7472656e64202e7061737352617465426172207b0a202020202020202020202020706f736974696f6e3a2072656c61746976653b0a20202020202020202020202077696474683a20313670783b0a2020202020202020202020206865696768743a20313030253b0a2020202020202020202020206d617267696e2d72696768743a203370783b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236666373637363b0a20202020202020207d0a0a20202020202020202e7472656e64202e7061737352617465426172202e7061737352617465207b0a202020202020202020202020706f736974696f6e3a206162736f6c7574653b0a202020202020202020202020626f74746f6d3a20303b0a20202020202020202020202077696474683a20313030253b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20233666636138333b0a20202020202020207d0a0a20202020202020202e7472656e64207461626c652e6661696c696e675465737473207b0a202020202020202020202020626f726465722d636f6c6c617073653a20636f6c6c617073653b0a20202020202020207d0a0a20202020202020202e7472656e64207461626c652e6661696c696e6754657374732074642c202e7472656e64207461626c652e6661696c696e675465737473207468207b0a202020202020202020202020746578742d616c69676e3a206c6566743b0a20202020202020202020202070616464696e673a2034707820313670782034707820303b0a202020202020202020202020626f726465722d626f74746f6d3a20317078202364616461646120646f747465643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e7465737444657461696c202e686973746f727920737667207b0a202020202020202020202020766572746963616c2d616c69676e3a206d6964646c653b0a2020202020202020202020206d617267696e3a2030203870783b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e7465737444657461696c202e686973746f72792073766720706f6c796c696e65207b0a20202020202020202020202066696c6c3a206e6f6e653b0a2020202020202020202020207374726f6b653a20233832393861663b0a2020202020202020202020207374726f6b652d77696474683a20312e353b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e7465737444657461696c202e686973746f72792073766720636972636c652e6661696c6564207b0a20202020202020202020202066696c6c3a207265643b0a20202020202020207d0a0a20202020202020202e64696666207b0a2020202020202020202020206d617267696e2d626f74746f6d3a20313670783b0a202020202020202020202020666f6e742d73697a653a20302e38656d3b0a202020202020202020202020636f6c6f723a20233532353235323b0a20202020202020207d0a0a20202020202020202e64696666202e646966665469746c65207b0a202020202020202020202020646973706c61793a20626c6f636b3b0a2020202020202020202020206d617267696e2d626f74746f6d3a203870783b0a202020202020202020202020636f6c6f723a206461726b677265793b0a202020202020202020202020666f6e742d73697a653a20312e31656d3b0a20202020202020207d0a0a20202020202020202e646966662064657461696c73207b0a2020202020202020202020206d617267696e2d626f74746f6d3a203470783b0a20202020202020207d0a0a20202020202020202e646966662073756d6d617279207b0a202020202020202020202020637572736f723a20706f696e7465723b0a202020202020202020202020666f6e742d7765696768743a20626f6c643b0a20202020202020207d0a0a20202020202020202e646966662073756d6d6172792e6e65774661696c75726573207b0a202020202020202020202020636f6c6f723a207265643b0a20202020202020207d0a0a20202020202020202e646966662073756d6d6172792e6669786564207b0a202020202020202020202020636f6c6f723a20233133396531333b0a20202020202020207d0a0a20202020202020202e64696666207461626c65207b0a202020202020202020202020626f726465722d636f6c6c617073653a20636f6c6c617073653b0a2020202020202020202020206d617267696e3a2034707820302038707820313670783b0a20202020202020207d0a0a20202020202020202e646966662074642c202e64696666207468207b0a202020202020202020202020746578742d616c69676e3a206c6566743b0a20202020202020202020202070616464696e673a2034707820313670782034707820303b0a202020202020202020202020626f726465722d626f74746f6d3a20317078202364616461646120646f747465643b0a20202020202020207d0a0a20202020202020202e7265706f727446696c746572207b0a2020202020202020202020206d617267696e2d626f74746f6d3a20313670783b0a202020202020202020202020666f6e742d73697a653a20302e39656d3b0a202020202020202020202020636f6c6f723a20233532353235323b0a20202020202020207d0a0a20202020202020202e7265706f727446696c74657220696e7075742e74657374536561726368207b0a20202020202020202020202077696474683a203530253b0a20202020202020202020202070616464696e673a20367078203870783b0a2020202020202020202020206d617267696e2d72696768743a20313670783b0a202020202020202020202020626f726465723a20317078202364616461646120736f6c69643b0a202020202020202020202020626f726465722d7261646975733a203470783b0a20202020202020207d0a0a20202020202020202e7265706f727446696c746572206c6162656c2e73746174757346696c746572207b0a2020202020202020202020206d617267696e2d72696768743a20313270783b0a202020202020202020202020637572736f723a20706f696e7465723b0a20202020202020207d0a0a20202020202020202e74657374526573756c7447726f75702e66696c74657265644f7574207b0a202020202020202020202020646973706c61793a206e6f6e653b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570486561646572207b0a20202020202020202020202070616464696e673a20313270782031367078203470783b0a202020202020202020202020666f6e742d73697a653a20302e38656d3b0a202020202020202020202020666f6e742d7765696768743a20626f6c643b0a202020202020202020202020636f6c6f723a20233832393861663b0a20202020202020207d0a0a20202020202020202e74657374526573756c7447726f7570207b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20233433633134333b0a2020202020202020202020206d617267696e2d6c6566743a203370783b0a2020202020202020202020206d617267696e2d626f74746f6d3a203370783b0a202020202020202020202020626f782d73697a696e673a20626f726465722d626f783b0a20202020202020207d0a0a20202020202020202e74657374526573756c7447726f75702e73656c6563746564207b0a202020202020202020202020626f726465723a2031707820776869746520736f6c69643b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20233030376266662021696d706f7274616e743b0a20202020202020207d0a0a20202020202020202e74657374526573756c7447726f75702e736b6970706564207b0a202020202020202020202020626f726465723a20327078206772617920736f6c69643b0a20202020202020207d0a0a20202020202020202e74657374526573756c7447726f75702e6661696c6564207b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a207265643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c6973742c0a20202020202020202e63617264436f6e7461696e65722e7465737444657461696c207b0a2020202020202020202020206d617267696e2d746f703a20313670783b0a20202020202020202020202070616464696e673a20313670783b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374207b0a202020202020202020202020636f6c6f723a20233963396339633b0a20202020202020202020202070616464696e673a20303b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207b0a202020202020202020202020637572736f723a2064656661756c743b0a202020202020202020202020626f726465722d626f74746f6d3a20317078202364616461646120646f747465643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e74657374537461747573207b0a202020202020202020202020666f6e742d73697a653a20312e32656d3b0a202020202020202020202020666f6e742d7765696768743a20626f6c643b0a202020202020202020202020636f6c6f723a20233133396531333b0a202020202020202020202020706f696e7465722d6576656e74733a206e6f6e653b0a202020202020202020202020646973706c61793a20696e6c696e652d626c6f636b3b0a2020202020202020202020206f766572666c6f773a2068696464656e3b0a202020202020202020202020666c6f61743a206c6566743b0a20202020202020202020202070616464696e672d746f703a20313070783b0a20202020202020202020202070616464696e672d6c6566743a20323070783b0a20202020202020202020202070616464696e672d72696768743a20313270783b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e746573745374617475732e736b6970706564207b0a202020202020202020202020636f6c6f723a20677261793b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e746573745374617475732e6661696c6564207b0a202020202020202020202020636f6c6f723a207265643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e746573745374617475732e696e746572727570746564207b0a202020202020202020202020636f6c6f723a20236666386331613b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e746573745469746c65207b0a202020202020202020202020666f6e742d73697a653a20302e39656d3b0a20202020202020202020202070616464696e673a2031327078203020313070783b0a202020202020202020202020646973706c61793a20696e6c696e652d626c6f636b3b0a202020202020202020202020706f696e7465722d6576656e74733a206e6f6e653b0a202020202020202020202020636f6c6f723a20233532353235323b0a202020202020202020202020746578742d6f766572666c6f773a20656c6c69707369733b0a2020202020202020202020206f766572666c6f773a2068696464656e3b0a20202020202020202020202077696474683a2063616c632831303025202d203233367078293b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e746573744475726174696f6e207b0a202020202020202020202020706f696e7465722d6576656e74733a206e6f6e653b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e74726565546f67676c65207b0a202020202020202020202020646973706c61793a20696e6c696e652d626c6f636b3b0a202020202020202020202020666c6f61743a206c6566743b0a20202020202020202020202077696474683a20313670783b0a20202020202020202020202070616464696e672d746f703a20313170783b0a202020202020202020202020637572736f723a20706f696e7465723b0a202020202020202020202020636f6c6f723a20233532353235323b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e74657374436f756e7473207b0a2020202020202020202020206d617267696e2d72696768743a203870783b0a202020202020202020202020666f6e742d73697a653a20302e38656d3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207370616e2e666c616b794261646765207b0a2020202020202020202020206d617267696e2d6c6566743a203870783b0a20202020202020202020202070616464696e673a2030203470783b0a202020202020202020202020626f726465722d7261646975733a203370783b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236234386164313b0a202020202020202020202020636f6c6f723a2077686974653b0a202020202020202020202020666f6e742d73697a653a20302e38656d3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e74657374547265654368696c6472656e207b0a2020202020202020202020206d617267696e2d6c6566743a20323470783b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e74657374547265654368696c6472656e2e636f6c6c6170736564207b0a202020202020202020202020646973706c61793a206e6f6e653b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f77207b0a202020202020202020202020706f736974696f6e3a2072656c61746976653b0a202020202020202020202020626f726465722d6c6566743a20347078202334336331343320736f6c69643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f772e736b6970706564207b0a202020202020202020202020636f6c6f723a20677261793b0a202020202020202020202020626f726465722d6c6566743a20347078206772617920736f6c69643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f772e6661696c6564207b0a202020202020202020202020636f6c6f723a207265643b0a202020202020202020202020626f726465722d6c6566743a203470782072656420736f6c69643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f772e696e746572727570746564207b0a202020202020202020202020626f726465722d6c6566743a20347078202366663863316120736f6c69643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e65722e7465737447726f75704c697374202e7465737447726f7570526f773a686f766572207b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236666666165613b0a2020202020202020202020207472616e736974696f6e3a20302e323530733b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574207b0a20202020202020202020202070616464696e673a203870782031367078203234707820313670783b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65207b0a202020202020202020202020646973706c61793a20626c6f636b3b0a202020202020202020202020666f6e742d66616d696c793a206d6f6e6f73706163653b0a20202020202020202020202070616464696e673a20313070783b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20233432343234323b0a202020202020202020202020636f6c6f723a20233161666630303b0a202020202020202020202020626f726465722d626f74746f6d3a20317078202331616666303020646f747465643b0a2020202020202020202020206f766572666c6f773a206175746f3b0a202020202020202020202020666f6e742d73697a653a20312e31656d3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c652e68696464656e207b0a202020202020202020202020646973706c61793a206e6f6e653b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e617373657274696f6e44696666207b0a202020202020202020202020646973706c61793a20666c65783b0a2020202020202020202020206d617267696e2d626f74746f6d3a203870783b0a202020202020202020202020666f6e742d73697a653a20302e39656d3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e617373657274696f6e44696666202e65787065637465642c202e63617264436f6e7461696e6572202e746573744f7574707574202e617373657274696f6e44696666202e61637475616c207b0a202020202020202020202020666c65783a20313b0a2020202020202020202020206d696e2d77696474683a20303b0a2020202020202020202020206d617267696e2d72696768743a203870783b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e617373657274696f6e44696666202e6c6162656c207b0a202020202020202020202020666f6e742d7765696768743a20626f6c643b0a202020202020202020202020636f6c6f723a20233532353235323b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e617373657274696f6e4469666620707265207b0a2020202020202020202020206d617267696e3a20347078203020303b0a20202020202020202020202070616464696e673a203870783b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236637663766373b0a202020202020202020202020626f726465723a20317078202364616461646120736f6c69643b0a20202020202020202020202077686974652d73706163653a207072652d777261703b0a202020202020202020202020776f72642d627265616b3a20627265616b2d616c6c3b0a202020202020202020202020636f6c6f723a20233432343234323b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e617373657274696f6e446966662064656c207b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236666633963393b0a202020202020202020202020746578742d6465636f726174696f6e3a206e6f6e653b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e617373657274696f6e4469666620696e73207b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236266663062663b0a202020202020202020202020746578742d6465636f726174696f6e3a206e6f6e653b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e7265717565737443617264207b0a2020202020202020202020206d617267696e2d626f74746f6d3a203870783b0a202020202020202020202020626f726465723a20317078202364306430643020736f6c69643b0a202020202020202020202020626f726465722d7261646975733a203470783b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236666666666663b0a202020202020202020202020666f6e742d73697a653a20302e39656d3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e7265717565737453756d6d617279207b0a202020202020202020202020646973706c61793a20666c65783b0a202020202020202020202020616c69676e2d6974656d733a2063656e7465723b0a20202020202020202020202070616464696e673a20367078203870783b0a202020202020202020202020626f726465722d626f74746f6d3a20317078202365366536653620736f6c69643b0a202020202020202020202020666f6e742d66616d696c793a206d6f6e6f73706163653b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e7265717565737453756d6d617279207370616e207b0a2020202020202020202020206d617267696e2d72696768743a20313270783b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e7265717565737453756d6d617279202e6d6574686f64207b0a202020202020202020202020666f6e742d7765696768743a20626f6c643b0a202020202020202020202020636f6c6f723a20233135363563303b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e7265717565737453756d6d617279202e75726c207b0a202020202020202020202020666c65783a20313b0a2020202020202020202020206d696e2d77696474683a20303b0a2020202020202020202020206f766572666c6f773a2068696464656e3b0a202020202020202020202020746578742d6f766572666c6f773a20656c6c69707369733b0a20202020202020202020202077686974652d73706163653a206e6f777261703b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e7265717565737453756d6d617279202e737461747573436f6465207b0a202020202020202020202020666f6e742d7765696768743a20626f6c643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e7265717565737453756d6d617279202e737461747573436f64652e73756363657373207b20636f6c6f723a20233265376433323b207d0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e7265717565737453756d6d617279202e737461747573436f64652e636c69656e744572726f72207b20636f6c6f723a20236439373330643b207d0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e7265717565737453756d6d617279202e737461747573436f64652e7365727665724572726f72207b20636f6c6f723a20236336323832383b207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e7265717565737453756d6d617279202e6c6174656e63792c202e63617264436f6e7461696e6572202e746573744f7574707574202e7265717565737453756d6d617279202e6170694e616d65207b0a202020202020202020202020636f6c6f723a20233830383038303b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e7265717565737453756d6d61727920627574746f6e2e636f70794375726c207b0a202020202020202020202020637572736f723a20706f696e7465723b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e7265717565737444657461696c73207b0a202020202020202020202020646973706c61793a20666c65783b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e7265717565737444657461696c73202e726571756573742c202e63617264436f6e7461696e6572202e746573744f7574707574202e7265717565737444657461696c73202e726573706f6e7365207b0a202020202020202020202020666c65783a20313b0a2020202020202020202020206d696e2d77696474683a20303b0a20202020202020202020202070616464696e673a20367078203870783b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e7265717565737444657461696c73202e736964655469746c65207b0a202020202020202020202020666f6e742d7765696768743a20626f6c643b0a202020202020202020202020636f6c6f723a20233532353235323b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e7265717565737444657461696c73207461626c652e68656164657273207b0a202020202020202020202020666f6e742d66616d696c793a206d6f6e6f73706163653b0a202020202020202020202020626f726465722d636f6c6c617073653a20636f6c6c617073653b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e7265717565737444657461696c73207461626c652e68656164657273207464207b0a20202020202020202020202070616464696e673a20317078203870782031707820303b0a202020202020202020202020766572746963616c2d616c69676e3a20746f703b0a202020202020202020202020776f72642d627265616b3a20627265616b2d616c6c3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e7265717565737444657461696c73207461626c652e686561646572732074643a66697273742d6368696c64207b0a202020202020202020202020636f6c6f723a20233830383038303b0a20202020202020202020202077686974652d73706163653a206e6f777261703b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e7265717565737444657461696c73207072652e626f6479207b0a2020202020202020202020206d617267696e3a20347078203020303b0a20202020202020202020202070616464696e673a203870783b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236637663766373b0a202020202020202020202020626f726465723a20317078202364616461646120736f6c69643b0a20202020202020202020202077686974652d73706163653a207072652d777261703b0a202020202020202020202020776f72642d627265616b3a20627265616b2d616c6c3b0a2020202020202020202020206d61782d6865696768743a2034303070783b0a2020202020202020202020206f766572666c6f773a206175746f3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e6c6f67456e7472696573207b0a2020202020202020202020206d617267696e2d626f74746f6d3a203870783b0a202020202020202020202020666f6e742d73697a653a20302e39656d3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e6c6f674c6576656c46696c746572206c6162656c207b0a2020202020202020202020206d617267696e2d72696768743a20313270783b0a202020202020202020202020637572736f723a20706f696e7465723b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574207461626c652e6c6f675461626c65207b0a20202020202020202020202077696474683a20313030253b0a2020202020202020202020206d617267696e2d746f703a203470783b0a202020202020202020202020626f726465722d636f6c6c617073653a20636f6c6c617073653b0a202020202020202020202020666f6e742d66616d696c793a206d6f6e6f73706163653b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574207461626c652e6c6f675461626c65207468207b0a202020202020202020202020746578742d616c69676e3a206c6566743b0a20202020202020202020202070616464696e673a20327078203870783b0a202020202020202020202020626f726465722d626f74746f6d3a20317078202364306430643020736f6c69643b0a202020202020202020202020636f6c6f723a20233532353235323b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574207461626c652e6c6f675461626c65207464207b0a20202020202020202020202070616464696e673a20327078203870783b0a202020202020202020202020766572746963616c2d616c69676e3a20746f703b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574207461626c652e6c6f675461626c652074642e6c6f6754696d65207b0a20202020202020202020202077686974652d73706163653a206e6f777261703b0a202020202020202020202020636f6c6f723a20233830383038303b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574207461626c652e6c6f675461626c652074642e6c6f674c6576656c207b0a202020202020202020202020666f6e742d7765696768743a20626f6c643b0a202020202020202020202020746578742d7472616e73666f726d3a207570706572636173653b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f75747075742074722e6c6f67456e7472792e64656275672074642e6c6f674c6576656c207b20636f6c6f723a20233830383038303b207d0a20202020202020202e63617264436f6e7461696e6572202e746573744f75747075742074722e6c6f67456e7472792e696e666f2074642e6c6f674c6576656c207b20636f6c6f723a20233135363563303b207d0a20202020202020202e63617264436f6e7461696e6572202e746573744f75747075742074722e6c6f67456e7472792e7761726e2074642e6c6f674c6576656c207b20636f6c6f723a20236439373330643b207d0a20202020202020202e63617264436f6e7461696e6572202e746573744f75747075742074722e6c6f67456e7472792e6572726f722074642e6c6f674c6576656c207b20636f6c6f723a20236336323832383b207d0a20202020202020202e63617264436f6e7461696e6572202e746573744f75747075742074722e6c6f67456e7472792e6572726f722074642e6c6f674d657373616765207b20636f6c6f723a20236336323832383b207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f75747075742074722e6c6f674669656c647320707265207b0a2020202020202020202020206d617267696e3a20302030203470783b0a20202020202020202020202070616464696e673a203870783b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236637663766373b0a202020202020202020202020626f726465723a20317078202364616461646120736f6c69643b0a20202020202020202020202077686974652d73706163653a207072652d777261703b0a202020202020202020202020776f72642d627265616b3a20627265616b2d616c6c3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f75747075742074722e6c6f674669656c64732e636f6c6c61707365642c202e63617264436f6e7461696e6572202e746573744f75747075742074722e66696c74657265644f7574207b0a202020202020202020202020646973706c61793a206e6f6e653b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f757470757420627574746f6e2e6669656c6473546f67676c65207b0a202020202020202020202020626f726465723a206e6f6e653b0a2020202020202020202020206261636b67726f756e643a206e6f6e653b0a20202020202020202020202070616464696e673a20303b0a202020202020202020202020636f6c6f723a20233135363563303b0a202020202020202020202020637572736f723a20706f696e7465723b0a202020202020202020202020666f6e742d66616d696c793a206d6f6e6f73706163653b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f757470757420627574746f6e2e7261774f7574707574546f67676c65207b0a2020202020202020202020206d617267696e2d626f74746f6d3a203870783b0a202020202020202020202020637572736f723a20706f696e7465723b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e7465737444657461696c207b0a202020202020202020202020626f726465722d626f74746f6d3a20317078202364306430643020736f6c69643b0a20202020202020202020202070616464696e673a20313670783b0a2020202020202020202020206261636b67726f756e642d636f6c6f723a20236536653665363b0a202020202020202020202020626f726465722d7261646975733a2030203020347078203470783b0a202020202020202020202020636f6c6f723a2064696d677265793b0a202020202020202020202020666f6e742d73697a653a20302e38656d3b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c652e736b69707065647b0a202020202020202020202020636f6c6f723a20236439643964393b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c652e6661696c6564207b0a202020202020202020202020636f6c6f723a20236666623262323b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d626f6c64207b20666f6e742d7765696768743a20626f6c643b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d6661696e74207b206f7061636974793a20302e373b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d6974616c6963207b20666f6e742d7374796c653a206974616c69633b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d756e6465726c696e65207b20746578742d6465636f726174696f6e3a20756e6465726c696e653b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d66672d30207b20636f6c6f723a20233165316531653b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d66672d31207b20636f6c6f723a20236666366236623b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d66672d32207b20636f6c6f723a20233563653635633b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d66672d33207b20636f6c6f723a20236632643934653b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d66672d34207b20636f6c6f723a20233662396266663b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d66672d35207b20636f6c6f723a20236530376265303b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d66672d36207b20636f6c6f723a20233465643964393b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d66672d37207b20636f6c6f723a20236439643964393b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d66672d38207b20636f6c6f723a20233830383038303b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d66672d39207b20636f6c6f723a20236666393939393b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d66672d3130207b20636f6c6f723a20233939666639393b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d66672d3131207b20636f6c6f723a20236666666638303b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d66672d3132207b20636f6c6f723a20233939626266663b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d66672d3133207b20636f6c6f723a20236666393966663b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d66672d3134207b20636f6c6f723a20233939666666663b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d66672d3135207b20636f6c6f723a20236666666666663b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d62672d30207b206261636b67726f756e642d636f6c6f723a20233165316531653b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d62672d31207b206261636b67726f756e642d636f6c6f723a20236666366236623b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d62672d32207b206261636b67726f756e642d636f6c6f723a20233563653635633b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d62672d33207b206261636b67726f756e642d636f6c6f723a20236632643934653b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d62672d34207b206261636b67726f756e642d636f6c6f723a20233662396266663b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d62672d35207b206261636b67726f756e642d636f6c6f723a20236530376265303b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d62672d36207b206261636b67726f756e642d636f6c6f723a20233465643964393b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d62672d37207b206261636b67726f756e642d636f6c6f723a20236439643964393b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d62672d38207b206261636b67726f756e642d636f6c6f723a20233830383038303b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d62672d39207b206261636b67726f756e642d636f6c6f723a20236666393939393b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d62672d3130207b206261636b67726f756e642d636f6c6f723a20233939666639393b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d62672d3131207b206261636b67726f756e642d636f6c6f723a20236666666638303b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d62672d3132207b206261636b67726f756e642d636f6c6f723a20233939626266663b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d62672d3133207b206261636b67726f756e642d636f6c6f723a20236666393966663b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d62672d3134207b206261636b67726f756e642d636f6c6f723a20233939666666663b207d0a20202020202020202e63617264436f6e7461696e6572202e636f6e736f6c65202e616e73692d62672d3135207b206261636b67726f756e642d636f6c6f723a20236666666666663b207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744f7574707574202e7465737444657461696c202e696e74657272757074696f6e207b0a202020202020202020202020636f6c6f723a20236439373330643b0a202020202020202020202020666f6e742d7765696768743a20626f6c643b0a20202020202020207d0a0a20202020202020202e63617264436f6e7461696e6572202e746573744475726174696f6e207b0a202020202020202020202020706f736974696f6e3a206162736f6c7574653b0a202020202020202020202020746f703a203570783b0a20202020202020202020202072696768743a203870783b0a202020202020202020202020746578742d616c69676e3a2072696768743b0a20202020202020202020202070616464696e672d72696768743a203870783b0a202020202020202020202020626f782d73697a696e673a20626f726465722d626f783b0a20202020202020207d0a202020203c2f7374796c653e0a3c2f686561643e0a3c626f64793e0a3c64697620636c6173733d2270616765486561646572223e0a202020203c7370616e20636c6173733d2270726f6a6563745469746c65223e7b7b2e5265706f72745469746c657d7d3c2f7370616e3e0a202020203c64697620636c6173733d22746573745374617473223e0a20202020202020203c7370616e20636c6173733d22746f74616c223e3c7370616e20636c6173733d22696e64696361746f72223e26626f78626f783b3c2f7370616e3e20546f74616c3a203c7374726f6e673e7b7b2e4e756d4f6654657374737d7d3c2f7374726f6e673e4475726174696f6e3a203c7374726f6e673e7b7b2e546573744475726174696f6e7d7d3c2f7374726f6e673e0a20202020202020203c2f7370616e3e3c7370616e20636c6173733d22706173736564223e3c7370616e20636c6173733d22696e64696361746f72223e26636865636b3b3c2f7370616e3e205061737365643a203c7374726f6e673e7b7b2e4e756d4f66546573745061737365647d7d3c2f7374726f6e673e0a20202020202020203c2f7370616e3e3c7370616e20636c6173733d22736b6970706564223e3c7370616e20636c6173733d22696e64696361746f72223e26646173683b3c2f7370616e3e20536b69707065643a203c7374726f6e673e7b7b2e4e756d4f6654657374536b69707065647d7d3c2f7374726f6e673e0a20202020202020203c2f7370616e3e3c7370616e20636c6173733d226661696c6564223e3c7370616e20636c6173733d22696e64696361746f72223e2663726f73733b3c2f7370616e3e204661696c65643a203c7374726f6e673e7b7b2e4e756d4f66546573744661696c65647d7d3c2f7374726f6e673e0a20202020202020203c2f7370616e3e3c7370616e20636c6173733d22666c616b79223e3c7370616e20636c6173733d22696e64696361746f72223e2623383737363b3c2f7370616e3e20466c616b793a203c7374726f6e673e7b7b2e4e756d4f6654657374466c616b797d7d3c2f7374726f6e673e0a20202020202020203c2f7370616e3e7b7b6966202e4e756d4f6654657374496e7465727275707465647d7d3c7370616e20636c6173733d22696e746572727570746564223e3c7370616e20636c6173733d22696e64696361746f72223e2623393838383b3c2f7370616e3e20496e7465727275707465643a203c7374726f6e673e7b7b2e4e756d4f6654657374496e7465727275707465647d7d3c2f7374726f6e673e0a20202020202020203c2f7370616e3e7b7b656e647d7d0a202020203c2f6469763e0a202020203c7370616e20636c6173733d227465737447726f7570735469746c65223e546573742047726f7570733a3c2f7370616e3e0a202020203c7370616e20636c6173733d2274657374457865637574696f6e44617465223e7b7b2e54657374457865637574696f6e446174657d7d3c2f7370616e3e0a3c2f6469763e0a3c64697620636c6173733d22746573745265706f7274436f6e7461696e6572223e0a202020207b7b6966202e52756e496e666f7d7d0a202020203c64657461696c7320636c6173733d2263617264436f6e7461696e65722072756e496e666f223e0a20202020202020203c73756d6d6172793e52756e20696e666f3c2f73756d6d6172793e0a20202020202020203c7461626c653e0a2020202020202020202020207b7b72616e6765202e52756e496e666f7d7d3c74723e3c74643e7b7b2e4e616d657d7d3c2f74643e3c74643e7b7b6966202e497355524c7d7d3c6120687265663d227b7b2e56616c75657d7d22207461726765743d225f626c616e6b222072656c3d226e6f6f70656e6572223e7b7b2e56616c75657d7d3c2f613e7b7b656c73657d7d7b7b2e56616c75657d7d7b7b656e647d7d3c2f74643e3c2f74723e7b7b656e647d7d0a20202020202020203c2f7461626c653e0a202020203c2f64657461696c733e0a202020207b7b656e647d7d0a202020207b7b77697468202e446966667d7d0a202020203c64697620636c6173733d2263617264436f6e7461696e65722064696666223e0a20202020202020203c7370616e20636c6173733d22646966665469746c65223e4368616e6765732073696e6365207b7b2e426173656c696e657d7d3a3c2f7370616e3e0a20202020202020203c64657461696c73206f70656e3e0a2020202020202020202020203c73756d6d61727920636c6173733d226e65774661696c75726573223e4e6577206661696c7572657320287b7b6c656e202e4e65774661696c757265737d7d293c2f73756d6d6172793e0a2020202020202020202020207b7b6966202e4e65774661696c757265737d7d0a2020202020202020202020203c7461626c653e0a202020202020202020202020202020203c74723e3c74683e546573743c2f74683e3c74683e5061636b6167653c2f74683e3c74683e426173656c696e65207374617475733c2f74683e3c2f74723e0a202020202020202020202020202020207b7b72616e6765202e4e65774661696c757265737d7d3c74723e3c74643e7b7b2e546573744e616d657d7d3c2f74643e3c74643e7b7b2e5061636b6167657d7d3c2f74643e3c74643e7b7b2e426173656c696e655374617475737d7d3c2f74643e3c2f74723e7b7b656e647d7d0a2020202020202020202020203c2f7461626c653e0a2020202020202020202020207b7b656e647d7d0a20202020202020203c2f64657461696c733e0a20202020202020203c64657461696c733e0a2020202020202020202020203c73756d6d61727920636c6173733d226669786564223e466978656420287b7b6c656e202e46697865647d7d293c2f73756d6d6172793e0a2020202020202020202020207b7b6966202e46697865647d7d0a2020202020202020202020203c7461626c653e0a202020202020202020202020202020203c74723e3c74683e546573743c2f74683e3c74683e5061636b6167653c2f74683e3c74683e5374617475733c2f74683e3c2f74723e0a202020202020202020202020202020207b7b72616e6765202e46697865647d7d3c74723e3c74643e7b7b2e546573744e616d657d7d3c2f74643e3c74643e7b7b2e5061636b6167657d7d3c2f74643e3c74643e7b7b2e5374617475737d7d3c2f74643e3c2f74723e7b7b656e647d7d0a2020202020202020202020203c2f7461626c653e0a2020202020202020202020207b7b656e647d7d0a20202020202020203c2f64657461696c733e0a20202020202020203c64657461696c733e0a2020202020202020202020203c73756d6d6172793e416464656420287b7b6c656e202e41646465647d7d293c2f73756d6d6172793e0a2020202020202020202020207b7b6966202e41646465647d7d0a2020202020202020202020203c7461626c653e0a202020202020202020202020202020203c74723e3c74683e546573743c2f74683e3c74683e5061636b6167653c2f74683e3c74683e5374617475733c2f74683e3c2f74723e0a202020202020202020202020202020207b7b72616e6765202e41646465647d7d3c74723e3c74643e7b7b2e546573744e616d657d7d3c2f74643e3c74643e7b7b2e5061636b6167657d7d3c2f74643e3c74643e7b7b2e5374617475737d7d3c2f74643e3c2f74723e7b7b656e647d7d0a2020202020202020202020203c2f7461626c653e0a2020202020202020202020207b7b656e647d7d0a20202020202020203c2f64657461696c733e0a20202020202020203c64657461696c733e0a2020202020202020202020203c73756d6d6172793e52656d6f76656420287b7b6c656e202e52656d6f7665647d7d293c2f73756d6d6172793e0a2020202020202020202020207b7b6966202e52656d6f7665647d7d0a2020202020202020202020203c7461626c653e0a202020202020202020202020202020203c74723e3c74683e546573743c2f74683e3c74683e5061636b6167653c2f74683e3c74683e426173656c696e65207374617475733c2f74683e3c2f74723e0a202020202020202020202020202020207b7b72616e6765202e52656d6f7665647d7d3c74723e3c74643e7b7b2e546573744e616d657d7d3c2f74643e3c74643e7b7b2e5061636b6167657d7d3c2f74643e3c74643e7b7b2e426173656c696e655374617475737d7d3c2f74643e3c2f74723e7b7b656e647d7d0a2020202020202020202020203c2f7461626c653e0a2020202020202020202020207b7b656e647d7d0a20202020202020203c2f64657461696c733e0a20202020202020203c64657461696c733e0a2020202020202020202020203c73756d6d6172793e52656e616d656420287b7b6c656e202e52656e616d65647d7d293c2f73756d6d6172793e0a2020202020202020202020207b7b6966202e52656e616d65647d7d0a2020202020202020202020203c7461626c653e0a202020202020202020202020202020203c74723e3c74683e546573743c2f74683e3c74683e5061636b6167653c2f74683e3c74683e426173656c696e65206e616d653c2f74683e3c2f74723e0a202020202020202020202020202020207b7b72616e6765202e52656e616d65647d7d3c74723e3c74643e7b7b2e546573744e616d657d7d3c2f74643e3c74643e7b7b2e5061636b6167657d7d3c2f74643e3c74643e7b7b2e426173656c696e65546573744e616d657d7d3c2f74643e3c2f74723e7b7b656e647d7d0a2020202020202020202020203c2f7461626c653e0a2020202020202020202020207b7b656e647d7d0a20202020202020203c2f64657461696c733e0a20202020202020203c64657461696c733e0a2020202020202020202020203c73756d6d6172793e536c6f776572206279206d6f7265207468616e207b7b2e4475726174696f6e5468726573686f6c647d7d2520287b7b6c656e202e536c6f7765727d7d293c2f73756d6d6172793e0a2020202020202020202020207b7b6966202e536c6f7765727d7d0a2020202020202020202020203c7461626c653e0a202020202020202020202020202020203c74723e3c74683e546573743c2f74683e3c74683e5061636b6167653c2f74683e3c74683e426173656c696e65206475726174696f6e3c2f74683e3c74683e4475726174696f6e3c2f74683e3c2f74723e0a202020202020202020202020202020207b7b72616e6765202e536c6f7765727d7d3c74723e3c74643e7b7b2e546573744e616d657d7d3c2f74643e3c74643e7b7b2e5061636b6167657d7d3c2f74643e3c74643e7b7b7072696e74662022252e336622202e426173656c696e65456c61707365647d7d733c2f74643e3c74643e7b7b7072696e74662022252e336622202e456c617073656454696d657d7d7320282b7b7b7072696e74662022252e306622202e496e6372656173657d7d25293c2f74643e3c2f74723e7b7b656e647d7d0a2020202020202020202020203c2f7461626c653e0a2020202020202020202020207b7b656e647d7d0a20202020202020203c2f64657461696c733e0a202020203c2f6469763e0a202020207b7b656e647d7d0a202020207b7b6966202e5472656e647d7d0a202020203c64697620636c6173733d2263617264436f6e7461696e6572207472656e64223e0a20202020202020203c7370616e20636c6173733d227472656e645469746c65223e506173732072617465206f6620746865206c617374207b7b6c656e202e5472656e642e52756e737d7d2072756e2873293a3c2f7370616e3e0a20202020202020203c64697620636c6173733d2270617373526174654368617274223e0a2020202020202020202020207b7b72616e6765202e5472656e642e52756e737d7d0a202020202020202020202020202020203c64697620636c6173733d22706173735261746542617222207469746c653d227b7b2e54696d657d7d7b7b6966202e436f6d6d69747d7d20287b7b2e436f6d6d69747d7d297b7b656e647d7d3a207b7b7072696e74662022252e316622202e50617373526174657d7d25207061737365642c207b7b2e4661696c65647d7d206661696c65642c207b7b2e536b69707065647d7d20736b6970706564223e3c64697620636c6173733d22706173735261746522207374796c653d226865696768743a207b7b7072696e74662022252e316622202e50617373526174657d7d25223e3c2f6469763e3c2f6469763e0a2020202020202020202020207b7b656e647d7d0a20202020202020203c2f6469763e0a20202020202020207b7b6966202e5472656e642e4661696c696e6754657374737d7d0a20202020202020203c7461626c6520636c6173733d226661696c696e675465737473223e0a2020202020202020202020203c74723e3c74683e4661696c696e6720746573743c2f74683e3c74683e5061636b6167653c2f74683e3c74683e4669727374206661696c65642061743c2f74683e3c2f74723e0a2020202020202020202020207b7b72616e6765202e5472656e642e4661696c696e6754657374737d7d0a202020202020202020202020202020203c74723e3c74643e7b7b2e546573744e616d657d7d3c2f74643e3c74643e7b7b2e5061636b6167657d7d3c2f74643e3c74643e7b7b2e46697273744661696c656441747d7d7b7b6966202e46697273744661696c6564436f6d6d69747d7d20287b7b2e46697273744661696c6564436f6d6d69747d7d297b7b656e647d7d3c2f74643e3c2f74723e0a2020202020202020202020207b7b656e647d7d0a20202020202020203c2f7461626c653e0a20202020202020207b7b656e647d7d0a202020203c2f6469763e0a202020207b7b656e647d7d0a202020207b7b6966202e54696d656c696e65737d7d0a202020203c64697620636c6173733d2263617264436f6e7461696e65722074696d656c696e65223e0a20202020202020203c7370616e20636c6173733d2274696d656c696e655469746c65223e54696d656c696e653a3c2f7370616e3e0a20202020202020207b7b72616e6765202e54696d656c696e65737d7d0a20202020202020203c64657461696c7320636c6173733d227061636b61676554696d656c696e65223e0a2020202020202020202020203c73756d6d6172793e7b7b2e5061636b6167654e616d657d7d20287b7b7072696e74662022252e336622202e4475726174696f6e7d7d732c20757020746f207b7b2e4d6178436f6e63757272656e63797d7d2074657374287329206174206f6e6365293c2f73756d6d6172793e0a2020202020202020202020207b7b72616e6765202e526f77737d7d0a2020202020202020202020203c64697620636c6173733d2274696d656c696e65526f77207b7b2e5374617475737d7d223e0a202020202020202020202020202020203c7370616e20636c6173733d2274696d656c696e654c6162656c22207469746c653d227b7b2e546573744e616d657d7d223e7b7b2e546573744e616d657d7d3c2f7370616e3e0a202020202020202020202020202020203c64697620636c6173733d2274696d656c696e65547261636b223e0a20202020202020202020202020202020202020207b7b72616e6765202e426172737d7d3c64697620636c6173733d2274696d656c696e6542617222207374796c653d226c6566743a207b7b7072696e74662022252e326622202e4c6566747d7d253b2077696474683a207b7b7072696e74662022252e326622202e57696474687d7d2522207469746c653d227b7b2e5469746c657d7d223e3c2f6469763e7b7b656e647d7d0a202020202020202020202020202020203c2f6469763e0a2020202020202020202020203c2f6469763e0a2020202020202020202020207b7b656e647d7d0a20202020202020203c2f64657461696c733e0a20202020202020207b7b656e647d7d0a202020203c2f6469763e0a202020207b7b656e647d7d0a202020203c64697620636c6173733d2263617264436f6e7461696e6572207265706f727446696c746572223e0a20202020202020203c696e70757420747970653d227365617263682220636c6173733d2274657374536561726368222069643d22746573745365617263682220706c616365686f6c6465723d225365617263682074657374206e616d65732c207469746c65732c207061636b6167657320616e64206f7574707574223e0a20202020202020203c6c6162656c20636c6173733d2273746174757346696c746572223e3c696e70757420747970653d22636865636b626f782220646174612d7374617475733d227061737365642220636865636b65643e205061737365643c2f6c6162656c3e0a20202020202020203c6c6162656c20636c6173733d2273746174757346696c746572223e3c696e70757420747970653d22636865636b626f782220646174612d7374617475733d226661696c65642220636865636b65643e204661696c65643c2f6c6162656c3e0a20202020202020203c6c6162656c20636c6173733d2273746174757346696c746572223e3c696e70757420747970653d22636865636b626f782220646174612d7374617475733d22736b69707065642220636865636b65643e20536b69707065643c2f6c6162656c3e0a202020203c2f6469763e0a202020203c64697620636c6173733d2263617264436f6e7461696e6572223e0a20202020202020203c6469762069643d2274657374526573756c7473223e0a2020202020202020202020207b7b72616e676520246b2c202476203a3d202e54657374526573756c74737d7d0a202020202020202020202020202020203c64697620636c6173733d2274657374526573756c7447726f7570207b7b2e4661696c757265496e64696361746f727d7d207b7b2e536b6970706564496e64696361746f727d7d222069643d227b7b246b7d7d22207469746c653d227b7b2e5061636b6167654e616d657d7d20287b7b7072696e74662022252e336622202e456c617073656454696d657d7d7329223e7b7b2e5061636b6167654e616d657d7d3c2f6469763e0a2020202020202020202020207b7b656e647d7d0a20202020202020203c2f6469763e0a202020203c2f6469763e0a202020203c64697620636c6173733d2263617264436f6e7461696e6572207465737447726f75704c697374222069643d227465737447726f75704c697374223e3c2f6469763e0a3c2f6469763e0a3c73637269707420747970653d226170706c69636174696f6e2f6a617661736372697074223e0a202020207b7b2e4a73436f64657d7d0a0a202020202f2a2a0a20202020202a204074797065207b54657374526573756c74737d0a20202020202a2f0a20202020636f6e73742064617461203d207b7b2e54657374526573756c74737d7d0a0a20202020636f6e7374206661696c546573744e616d65203d207b7b2e4661696c6564546573744e616d65737d7d0a0a20202020636f6e7374207265706f7274203d2077696e646f772e476f546573745265706f7274287b0a2020202020202020202020202020202020202020202020202020202020202020202020202020202020646174613a20646174612c0a202020202020202020202020202020202020202020202020202020202020202020202020202020202074657374526573756c7473456c656d3a20646f63756d656e742e676574456c656d656e7442794964282774657374526573756c747327292c0a20202020202020202020202020202020202020202020202020202020202020202020202020202020207465737447726f75704c697374456c656d3a20646f63756d656e742e676574456c656d656e744279496428277465737447726f75704c69737427292c0a2020202020202020202020202020202020202020202020202020202020202020202020202020202020736561726368496e707574456c656d3a20646f63756d656e742e676574456c656d656e744279496428277465737453656172636827292c0a202020202020202020202020202020202020202020202020202020202020202020202020202020202073746174757346696c746572456c656d733a20646f63756d656e742e717565727953656c6563746f72416c6c28272e73746174757346696c74657220696e70757427290a2020202020202020202020202020202020202020202020202020202020202020202020202020207d293b0a0a2020202066756e6374696f6e206765744c6173745365676d656e74287061636b6167654e616d6529207b0a202020202020766172207365676d656e7473203d207061636b6167654e616d652e73706c697428272f27293b0a20202020202072657475726e207365676d656e74735b7365676d656e74732e6c656e677468202d20315d3b0a202020207d0a0a20202020766172207061636b616765456c656d656e7473203d20646f63756d656e742e676574456c656d656e74734279436c6173734e616d65282774657374526573756c7447726f757027293b0a20202020666f7220287661722069203d20303b2069203c207061636b616765456c656d656e74732e6c656e6774683b20692b2b29207b0a202020202020766172207061636b6167654e616d65203d207061636b616765456c656d656e74735b695d2e74657874436f6e74656e743b0a202020202020766172206c6173745365676d656e74203d206765744c6173745365676d656e74287061636b6167654e616d65293b0a2020202020207061636b616765456c656d656e74735b695d2e74657874436f6e74656e74203d206c6173745365676d656e743b0a202020207d0a0a3c2f7363726970743e0a3c2f626f64793e0a3c2f68746d6c3e0a`
+
+var testReportJsCode = `2f2a2a0a202a20407479706564656620546573745374617475730a202a204070726f7065727479207b737472696e677d20546573744e616d650a202a204070726f7065727479207b737472696e677d205061636b6167650a202a204070726f7065727479207b6e756d6265727d20456c617073656454696d650a202a204070726f7065727479207b41727261792e3c737472696e673e7d204f75747075740a202a204070726f7065727479207b626f6f6c65616e7d205061737365640a202a204070726f7065727479207b626f6f6c65616e7d20536b69707065640a202a204070726f7065727479207b626f6f6c65616e7d20496e7465727275707465640a202a204070726f7065727479207b737472696e677d20496e74657272757074526561736f6e0a202a204070726f7065727479207b737472696e677d2053686172640a202a204070726f7065727479207b737472696e677d205469746c650a202a204070726f7065727479207b626f6f6c65616e7d204f6d69747465640a202a204070726f7065727479207b54657374486973746f72797d20486973746f72790a202a204070726f7065727479207b41727261792e3c54657374417474656d70743e7d20417474656d707473205468652072756e73206f662061207465737420746861742072616e207365766572616c2074696d65733b206974732073746174757320697320746865206f6e65206f66207468650a202a20202020202020202020206c61737420617474656d70742e0a202a204070726f7065727479207b626f6f6c65616e7d20466c616b790a202a204070726f7065727479207b41727261792e3c417373657274696f6e446966663e7d20417373657274696f6e732054686520657870656374656420616e642061637475616c2076616c756573206f6620746865206661696c656420617373657274696f6e732e0a202a204070726f7065727479207b41727261792e3c4c6f67456e7472793e7d204c6f67456e7472696573205468652067756e6974204a534f4e206c6f67207265636f726473206f662074686520746573742e0a202a204070726f7065727479207b41727261792e3c417069526571756573743e7d2052657175657374732054686520485454502063616c6c73206c6f6767656420627920746865207465737420776974682067756e69742052657175657374417069207265636f7264732e0a202a2f0a636c6173732054657374537461747573207b7d0a0a2f2a2a0a202a20407479706564656620417069526571756573740a202a204070726f7065727479207b737472696e677d2054696d650a202a204070726f7065727479207b737472696e677d204e616d650a202a204070726f7065727479207b737472696e677d204d6574686f640a202a204070726f7065727479207b737472696e677d2055524c0a202a204070726f7065727479207b4f626a6563742e3c737472696e672c20737472696e673e7d20486561646572730a202a204070726f7065727479207b737472696e677d20426f64790a202a204070726f7065727479207b737472696e677d205374617475730a202a204070726f7065727479207b4f626a6563742e3c737472696e672c20737472696e673e7d20526573706f6e7365486561646572730a202a204070726f7065727479207b737472696e677d20526573706f6e7365426f64790a202a204070726f7065727479207b737472696e677d204c6174656e63790a202a2f0a636c6173732041706952657175657374207b7d0a0a2f2a2a0a202a204074797065646566204c6f67456e7472790a202a204070726f7065727479207b737472696e677d2054696d650a202a204070726f7065727479207b737472696e677d204c6576656c0a202a204070726f7065727479207b737472696e677d204d6573736167650a202a204070726f7065727479207b4f626a6563742e3c737472696e672c202a3e7d204669656c647320546865206f746865722061747472696275746573206f6620746865206c6f67207265636f72642e0a202a2f0a636c617373204c6f67456e747279207b7d0a0a2f2a2a0a202a20407479706564656620417373657274696f6e446966660a202a204070726f7065727479207b737472696e677d2045787065637465640a202a204070726f7065727479207b737472696e677d2041637475616c0a202a2f0a636c61737320417373657274696f6e44696666207b7d0a0a2f2a2a0a202a2040747970656465662044696666546f6b656e0a202a204070726f7065727479207b737472696e677d20746578740a202a204070726f7065727479207b626f6f6c65616e7d206368616e67656420547275652069662074686520746f6b656e206973206d697373696e672066726f6d20746865206f746865722076616c75652e0a202a2f0a636c6173732044696666546f6b656e207b7d0a0a2f2a2a0a202a20407479706564656620416e73695365676d656e740a202a204070726f7065727479207b737472696e677d20746578740a202a204070726f7065727479207b41727261792e3c737472696e673e7d20636c61737365732054686520636c6173736573206f662074686520746578743a2022616e73692d626f6c64222c2022616e73692d6661696e74222c2022616e73692d6974616c6963222c0a202a202020202020202020202022616e73692d756e6465726c696e65222c2022616e73692d66672d3c302d31353e2220616e642022616e73692d62672d3c302d31353e222e0a202a204070726f7065727479207b737472696e677d205b636f6c6f725d2054686520666f726567726f756e6420636f6c6f72206f662032353620616e642032342d62697420636f6c6f7220636f6465732c20652e672e2022726762283235352c3133352c3029222e0a202a204070726f7065727479207b737472696e677d205b6261636b67726f756e64436f6c6f725d0a202a2f0a636c61737320416e73695365676d656e74207b7d0a0a2f2a2a0a202a2040747970656465662054657374417474656d70740a202a204070726f7065727479207b626f6f6c65616e7d205061737365640a202a204070726f7065727479207b626f6f6c65616e7d20536b69707065640a202a204070726f7065727479207b6e756d6265727d20456c617073656454696d650a202a204070726f7065727479207b41727261792e3c737472696e673e7d204f75747075740a202a2f0a636c6173732054657374417474656d7074207b7d0a0a2f2a2a0a202a2040747970656465662054657374486973746f72790a202a204070726f7065727479207b41727261792e3c3f6e756d6265723e7d204475726174696f6e7320546865206475726174696f6e73206f66207468652074657374206f76657220746865206c6173742072756e732c206f6c646573742066697273742c206f72206e756c6c20666f72207468650a202a202020202020202020202072756e7320776974686f75742074686520746573742e0a202a204070726f7065727479207b41727261792e3c737472696e673e7d2053746174757365732022706173736564222c20226661696c6564222c2022736b697070656422206f7220222220666f7220656163682072756e2e0a202a204070726f7065727479207b737472696e677d2046697273744661696c65644174205468652066697273742072756e206f66207468652063757272656e74206661696c7572652073747265616b206f662061206661696c656420746573742e0a202a204070726f7065727479207b737472696e677d2046697273744661696c6564436f6d6d69740a202a2f0a636c6173732054657374486973746f7279207b7d0a0a2f2a2a0a202a204074797065646566205465737447726f7570446174610a202a204074797065207b6f626a6563747d0a202a204070726f7065727479207b737472696e677d204661696c757265496e64696361746f720a202a204070726f7065727479207b737472696e677d20536b6970706564496e64696361746f720a202a204070726f7065727479207b41727261792e3c546573745374617475733e7d0a202a2f0a636c617373205465737447726f757044617461207b7d0a0a2f2a2a0a202a2040747970656465662054657374526573756c74730a202a204074797065207b41727261792e3c5465737447726f7570446174613e7d0a202a2f0a636c6173732054657374526573756c747320657874656e6473204172726179207b7d0a0a2f2a2a0a202a2040747970656465662053656c65637465644974656d730a202a204070726f7065727479207b48544d4c456c656d656e747c4576656e745461726765747d2074657374526573756c74730a202a204070726f7065727479207b537472696e677d2073656c65637465645465737447726f7570436f6c6f720a202a2f0a636c6173732053656c65637465644974656d73207b7d0a0a2f2a2a0a202a2040747970656465662054657374547265654e6f64650a202a204070726f7065727479207b737472696e677d206e616d6520546865206c617374207365676d656e74206f66207468652074657374206e616d652e0a202a204070726f7065727479207b6e756d6265727d20696e6465782054686520696e646578206f6620746865207465737420696e2074686520746573742067726f75702c206f72202d3120696620676f207465737420646964206e6f74207265706f72742069742e0a202a204070726f7065727479207b546573745374617475737d20746573745374617475730a202a204070726f7065727479207b41727261792e3c54657374547265654e6f64653e7d206368696c6472656e205468652073756274657374732e0a202a204070726f7065727479207b6e756d6265727d2070617373656420546865206e756d626572206f662070617373656420746573747320696e207468697320737562747265652e0a202a204070726f7065727479207b6e756d6265727d206661696c656420546865206e756d626572206f66206661696c656420746573747320696e207468697320737562747265652e0a202a204070726f7065727479207b6e756d6265727d20736b697070656420546865206e756d626572206f6620736b697070656420746573747320696e207468697320737562747265652e0a202a204070726f7065727479207b6e756d6265727d20656c617073656454696d650a202a2f0a636c6173732054657374547265654e6f6465207b7d0a0a2f2a2a0a202a204074797065646566205465737446696c7465720a202a204070726f7065727479207b737472696e677d20717565727920546865207465787420736561726368656420696e2074657374206e616d65732c2067756e6974207469746c65732c207061636b6167657320616e64206f75747075742e0a202a204070726f7065727479207b41727261792e3c737472696e673e7d20737461747573657320546865207374617475736573206f66207468652073686f776e2074657374733a2022706173736564222c20226661696c65642220616e642f6f722022736b6970706564222e0a202a2f0a636c617373205465737446696c746572207b7d0a0a2f2a2a0a202a20407479706564656620476f546573745265706f7274456c656d656e74730a202a204070726f7065727479207b54657374526573756c74737d20646174610a202a204070726f7065727479207b48544d4c456c656d656e747d2074657374526573756c7473456c656d0a202a204070726f7065727479207b48544d4c456c656d656e747d207465737447726f75704c697374456c656d0a202a204070726f7065727479207b48544d4c496e707574456c656d656e747d205b736561726368496e707574456c656d5d0a202a204070726f7065727479207b4e6f64654c6973744f663c48544d4c496e707574456c656d656e743e7d205b73746174757346696c746572456c656d735d20436865636b626f7865732077686f736520646174612d737461747573206174747269627574652069732022706173736564222c0a202a2020202020202020202020226661696c656422206f722022736b6970706564222e0a202a2f0a636c61737320476f546573745265706f7274456c656d656e7473207b7d0a0a0a2f2a2a0a202a204d61696e20656e74727920706f696e7420666f7220476f546573745265706f72742e0a202a2040706172616d207b476f546573745265706f7274456c656d656e74737d20656c656d656e74730a202a204072657475726e73207b7b74657374526573756c7473436c69636b48616e646c65723a2074657374526573756c7473436c69636b48616e646c65727d7d0a202a2040636f6e7374727563746f720a202a2f0a77696e646f772e476f546573745265706f7274203d2066756e6374696f6e2028656c656d656e747329207b0a2020636f6e7374202f2a2a4074797065207b53656c65637465644974656d737d2a2f2073656c65637465644974656d73203d207b0a2020202074657374526573756c74733a206e756c6c2c0a2020202073656c65637465645465737447726f7570436f6c6f723a206e756c6c0a20207d0a0a2020636f6e7374207465737453746174757346696c74657273203d205b27706173736564272c20276661696c6564272c2027736b6970706564275d0a20206c6574202f2a2a4074797065207b5465737446696c7465727d2a2f2066696c7465725374617465203d207b71756572793a2027272c2073746174757365733a207465737453746174757346696c746572732e736c69636528297d0a2020636f6e737420736561726368546578744361636865203d206e6577205765616b4d617028290a20202f2f206265796f6e642074686973206e756d626572206f6620746f6b656e2070616972732c2074686520646966666572656e636573206f6620616e20617373657274696f6e20617265206e6f7420736561726368656420666f7220636f6d6d6f6e20746f6b656e730a2020636f6e7374206d61784469666643656c6c73203d20313030303030300a20202f2f20746865206573636170652073657175656e636573206f662061207465726d696e616c3a204353492073657175656e6365732c206f66207768696368205347522028226d222920736574732074686520636f6c6f72732c20616e64204f53432073657175656e6365730a2020636f6e737420616e73694573636170655265676578203d202f5c7530303162283f3a5c5b285b302d393b3f5d2a29285b402d7e5d297c5c5d5b5e5c75303030375c75303031625d2a283f3a5c75303030377c5c75303031625c5c29292f670a2020636f6e737420616e7369436f6c6f724c6576656c73203d205b302c2039352c203133352c203137352c203231352c203235355d0a2020636f6e7374206c6f674c6576656c73203d205b276465627567272c2027696e666f272c20277761726e272c20276572726f72275d0a0a202066756e6374696f6e206164644576656e7444617461286576656e7429207b0a20202020696620286576656e742e64617461203d3d206e756c6c29207b0a2020202020206576656e742e64617461203d207b7461726765743a206576656e742e7461726765747d0a202020207d0a2020202072657475726e206576656e740a20207d0a0a0a2020636f6e737420676f546573745265706f7274203d207b0a202020202f2a2a0a20202020202a20496e766f6b6564207768656e2061207573657220636c69636b73206f6e206f6e65206f662074686520746573742067726f75702064697620656c656d656e74732e0a20202020202a2040706172616d207b48544d4c456c656d656e747d207461726765742054686520656c656d656e74206173736f63696174656420776974682074686520746573742067726f75702e0a20202020202a2040706172616d207b626f6f6c65616e7d2073686966744b657920496620707265737365642c20616c6c206f6620746573742064657461696c206173736f63696174656420746f2074686520746573742067726f75702069732073686f776e2e0a20202020202a2040706172616d207b54657374526573756c74737d20646174610a20202020202a2040706172616d207b53656c65637465644974656d737d2073656c65637465644974656d730a20202020202a2040706172616d207b66756e6374696f6e287461726765743a20456c656d656e742c20646174613a2054657374526573756c7473297d207465737447726f75704c69737448616e646c65720a20202020202a2f0a2020202074657374526573756c7473436c69636b48616e646c65723a2066756e6374696f6e20287461726765742c0a20202020202020202020202020202020202020202020202020202020202020202020202020202073686966744b65792c0a202020202020202020202020202020202020202020202020202020202020202020202020202020646174612c0a20202020202020202020202020202020202020202020202020202020202020202020202020202073656c65637465644974656d732c0a2020202020202020202020202020202020202020202020202020202020202020202020202020207465737447726f75704c69737448616e646c657229207b0a0a202020202020696620287461726765742e636c6173734c6973742e636f6e7461696e73282774657374526573756c7447726f75702729203d3d3d2066616c736529207b0a202020202020202072657475726e0a2020202020207d0a2020202020206966202873656c65637465644974656d732e74657374526573756c747320213d206e756c6c29207b0a20202020202020206c65742074657374526573756c7473456c656d656e74203d202f2a2a4074797065207b48544d4c456c656d656e747d2a2f2073656c65637465644974656d732e74657374526573756c74730a202020202020202074657374526573756c7473456c656d656e742e636c6173734c6973742e72656d6f7665282273656c656374656422290a202020202020202074657374526573756c7473456c656d656e742e7374796c652e6261636b67726f756e64436f6c6f72203d2073656c65637465644974656d732e73656c65637465645465737447726f7570436f6c6f720a2020202020207d0a202020202020636f6e7374207465737447726f75704964203d202f2a2a4074797065207b6e756d6265727d2a2f207461726765742e69640a20202020202069662028287461726765742e6964203d3d3d20756e646566696e6564290a20202020202020207c7c2028646174615b7465737447726f757049645d203d3d3d20756e646566696e6564290a20202020202020207c7c2028646174615b7465737447726f757049645d5b2754657374526573756c7473275d203d3d3d20756e646566696e65642929207b0a202020202020202072657475726e0a2020202020207d0a20202020202073656c65637465644974656d732e73656c65637465645465737447726f7570436f6c6f72203d20676574436f6d70757465645374796c6528746172676574292e67657450726f706572747956616c756528276261636b67726f756e642d636f6c6f7227290a20202020202073656c65637465644974656d732e74657374526573756c7473203d207461726765740a2020202020207461726765742e636c6173734c6973742e616464282273656c656374656422290a202020202020636f6e737420746573744964203d202f2a2a4074797065207b737472696e677d2a2f207461726765742e617474726962757465735b276964275d2e76616c75650a202020202020636f6e7374207465737447726f75704c697374456c656d203d20656c656d656e74732e7465737447726f75704c697374456c656d0a20202020202072656e6465725465737447726f75704c69737428646174612c205b7465737449645d290a0a202020202020636f6e7374207465737447726f7570526f7773203d207465737447726f75704c697374456c656d2e717565727953656c6563746f72416c6c28272e7465737447726f7570526f7727290a2020202020206966202873686966744b657929207b0a20202020202020207465737447726f75704c697374456c656d2e717565727953656c6563746f72416c6c28272e74657374547265654368696c6472656e2e636f6c6c617073656427290a202020202020202020202020202020202020202020202020202e666f72456163682828656c656d29203d3e20656c656d2e636c6173734c6973742e72656d6f76652827636f6c6c61707365642729290a20202020202020207465737447726f75704c697374456c656d2e717565727953656c6563746f72416c6c28272e74726565546f67676c6527290a202020202020202020202020202020202020202020202020202e666f72456163682828656c656d29203d3e20656c656d2e74657874436f6e74656e74203d2028656c656d2e74657874436f6e74656e74203d3d3d20272729203f202727203a20275c753235626527290a20202020202020207465737447726f75704c697374456c656d2e717565727953656c6563746f72416c6c28272e7465737447726f7570526f7727290a202020202020202020202020202020202020202020202020202e666f72456163682828656c656d29203d3e207465737447726f75704c69737448616e646c657228656c656d2c206461746129290a2020202020207d20656c736520696620287465737447726f7570526f77732e6c656e677468203d3d3d203129207b0a20202020202020207465737447726f75704c69737448616e646c6572287465737447726f7570526f77735b305d2c2064617461290a2020202020207d0a202020207d2c0a0a202020202f2a2a0a20202020202a0a20202020202a2040706172616d207b456c656d656e747d207461726765740a20202020202a2040706172616d207b54657374526573756c74737d20646174610a20202020202a2f0a202020207465737447726f75704c69737448616e646c65723a2066756e6374696f6e20287461726765742c206461746129207b0a202020202020636f6e73742061747472696273203d207461726765745b2761747472696275746573275d0a20202020202069662028617474726962732e6861734f776e50726f70657274792827646174612d67726f75706964272929207b0a2020202020202020636f6e73742067726f75704964203d202f2a2a4074797065207b6e756d6265727d2a2f20617474726962735b27646174612d67726f75706964275d2e76616c75650a2020202020202020636f6e73742074657374496e646578203d202f2a2a4074797065207b6e756d6265727d2a2f20617474726962735b27646174612d696e646578275d2e76616c75650a2020202020202020636f6e73742074657374537461747573203d202f2a2a4074797065207b546573745374617475737d2a2f20646174615b67726f757049645d5b2754657374526573756c7473275d5b74657374496e6465785d0a2020202020202020636f6e737420746573744f7574707574446976203d202f2a2a4074797065207b48544d4c446976456c656d656e747d2a2f207461726765742e717565727953656c6563746f7228276469762e746573744f757470757427290a0a202020202020202069662028746573744f7574707574446976203d3d206e756c6c29207b0a20202020202020202020636f6e737420746573744f7574707574446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a20202020202020202020746573744f75747075744469762e636c6173734c6973742e6164642827746573744f757470757427290a20202020202020202020636f6e737420636f6e736f6c65507265203d20646f63756d656e742e637265617465456c656d656e74282770726527290a20202020202020202020636f6e736f6c655072652e636c6173734c6973742e6164642827636f6e736f6c6527290a20202020202020202020636f6e7374207465737444657461696c446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a202020202020202020207465737444657461696c4469762e636c6173734c6973742e61646428277465737444657461696c27290a20202020202020202020636f6e7374207061636b6167654e616d65446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a202020202020202020207061636b6167654e616d654469762e636c6173734c6973742e61646428277061636b61676527290a202020202020202020207061636b6167654e616d654469762e617070656e64286372656174654c6162656c456c656d656e7428275061636b6167653a27292c206020247b746573745374617475732e5061636b6167657d60290a20202020202020202020636f6e7374207465737446696c654e616d65446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a202020202020202020207465737446696c654e616d654469762e636c6173734c6973742e616464282766696c656e616d6527290a2020202020202020202069662028746573745374617475732e5465737446696c654e616d652e7472696d2829203d3d3d20222229207b0a2020202020202020202020207465737446696c654e616d654469762e617070656e64286372656174654c6162656c456c656d656e74282746696c656e616d653a27292c2027206e2f61205c75303061305c753030613027290a202020202020202020207d20656c7365207b0a2020202020202020202020207465737446696c654e616d654469762e617070656e64286372656174654c6162656c456c656d656e74282746696c656e616d653a27292c206020247b746573745374617475732e5465737446696c654e616d657d205c75303061305c7530306130602c0a20202020202020202020202020202020202020202020202020202020202020202020206372656174654c6162656c456c656d656e7428274c696e653a27292c206020247b746573745374617475732e5465737446756e6374696f6e44657461696c2e4c696e657d20602c0a20202020202020202020202020202020202020202020202020202020202020202020206372656174654c6162656c456c656d656e742827436f6c3a27292c206020247b746573745374617475732e5465737446756e6374696f6e44657461696c2e436f6c7d60290a202020202020202020207d0a202020202020202020207465737444657461696c4469762e696e7365727441646a6163656e74456c656d656e7428276265666f7265656e64272c207061636b6167654e616d65446976290a202020202020202020207465737444657461696c4469762e696e7365727441646a6163656e74456c656d656e7428276265666f7265656e64272c207465737446696c654e616d65446976290a2020202020202020202069662028746573745374617475732e536861726429207b0a202020202020202020202020636f6e7374207368617264446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a20202020202020202020202073686172644469762e636c6173734c6973742e6164642827736861726427290a20202020202020202020202073686172644469762e617070656e64286372656174654c6162656c456c656d656e74282753686172643a27292c206020247b746573745374617475732e53686172647d60290a2020202020202020202020207465737444657461696c4469762e696e7365727441646a6163656e74456c656d656e7428276265666f7265656e64272c207368617264446976290a202020202020202020207d0a2020202020202020202069662028746573745374617475732e496e746572727570746564203d3d3d207472756529207b0a202020202020202020202020636f6e737420696e74657272757074696f6e446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a202020202020202020202020696e74657272757074696f6e4469762e636c6173734c6973742e6164642827696e74657272757074696f6e27290a202020202020202020202020696e74657272757074696f6e4469762e74657874436f6e74656e74203d2028746573745374617475732e496e74657272757074526561736f6e203d3d3d202774696d656f757427290a20202020202020202020202020203f2027496e7465727275707465643a2074686520746573742074696d6564206f7574206265666f72652069742066696e6973686564270a20202020202020202020202020203a202828746573745374617475732e496e74657272757074526561736f6e203d3d3d202770616e696327290a202020202020202020202020202020203f2027496e7465727275707465643a20612070616e69632073746f707065642074686520746573742062696e617279206265666f72652074686520746573742066696e6973686564270a202020202020202020202020202020203a2027496e7465727275707465643a207468652074657374206e65766572207265706f72746564206120726573756c7427290a2020202020202020202020207465737444657461696c4469762e696e7365727441646a6163656e74456c656d656e7428276265666f7265656e64272c20696e74657272757074696f6e446976290a202020202020202020207d0a2020202020202020202069662028746573745374617475732e417474656d70747320213d206e756c6c20262620746573745374617475732e417474656d7074732e6c656e677468203e203029207b0a202020202020202020202020636f6e737420617474656d707473446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a202020202020202020202020617474656d7074734469762e636c6173734c6973742e6164642827617474656d70747327290a202020202020202020202020636f6e737420617474656d707473203d20746573745374617475732e417474656d7074732e6d61702828617474656d707429203d3e0a202020202020202020202020202060247b617474656d70742e506173736564203f20275c753237313327203a2028617474656d70742e536b6970706564203f20275c753230313027203a20275c753237313727297d20247b617474656d70742e456c617073656454696d657d7360290a202020202020202020202020617474656d7074734469762e617070656e64286372656174654c6162656c456c656d656e742827417474656d7074733a27292c206020247b617474656d7074732e6a6f696e28272c2027297d60290a2020202020202020202020207465737444657461696c4469762e696e7365727441646a6163656e74456c656d656e7428276265666f7265656e64272c20617474656d707473446976290a202020202020202020207d0a2020202020202020202069662028746573745374617475732e486973746f727920213d206e756c6c29207b0a2020202020202020202020207465737444657461696c4469762e696e7365727441646a6163656e74456c656d656e7428276265666f7265656e64272c2063726561746554657374486973746f7279456c656d656e7428746573745374617475732e486973746f727929290a202020202020202020207d0a20202020202020202020746573744f75747075744469762e696e7365727441646a6163656e74456c656d656e7428276166746572626567696e272c20636f6e736f6c65507265290a20202020202020202020636f6e737420686173417373657274696f6e73203d20746573745374617475732e417373657274696f6e7320213d206e756c6c20262620746573745374617475732e417373657274696f6e732e6c656e677468203e20300a2020202020202020202069662028686173417373657274696f6e7329207b0a2020202020202020202020202f2f2074686520617373657274696f6e206469666673207265706c6163652074686520726177206f75747075742c2077686963682072656d61696e7320617661696c61626c6520626568696e64206120746f67676c650a202020202020202020202020746573744f75747075744469762e696e7365727441646a6163656e74456c656d656e7428276166746572626567696e272c206372656174655261774f7574707574546f67676c6528636f6e736f6c6550726529290a202020202020202020207d0a2020202020202020202069662028746573745374617475732e4c6f67456e747269657320213d206e756c6c20262620746573745374617475732e4c6f67456e74726965732e6c656e677468203e203029207b0a202020202020202020202020746573744f75747075744469762e696e7365727441646a6163656e74456c656d656e7428276166746572626567696e272c206372656174654c6f67456e7472696573456c656d656e7428746573745374617475732e4c6f67456e747269657329290a202020202020202020207d0a2020202020202020202069662028746573745374617475732e526571756573747320213d206e756c6c20262620746573745374617475732e52657175657374732e6c656e677468203e203029207b0a202020202020202020202020636f6e737420726571756573744361726473446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a2020202020202020202020207265717565737443617264734469762e636c6173734c6973742e616464282772657175657374436172647327290a202020202020202020202020746573745374617475732e52657175657374732e666f724561636828287265717565737429203d3e207265717565737443617264734469762e617070656e644368696c64286372656174655265717565737443617264456c656d656e7428726571756573742929290a202020202020202020202020746573744f75747075744469762e696e7365727441646a6163656e74456c656d656e7428276166746572626567696e272c20726571756573744361726473446976290a202020202020202020207d0a2020202020202020202069662028686173417373657274696f6e7329207b0a202020202020202020202020746573745374617475732e417373657274696f6e730a202020202020202020202020202020202020202020202e736c69636528290a202020202020202020202020202020202020202020202e7265766572736528290a202020202020202020202020202020202020202020202e666f72456163682828617373657274696f6e29203d3e0a202020202020202020202020202020202020202020202020746573744f75747075744469762e696e7365727441646a6163656e74456c656d656e7428276166746572626567696e272c20637265617465417373657274696f6e44696666456c656d656e7428617373657274696f6e2929290a202020202020202020207d0a20202020202020202020746573744f75747075744469762e696e7365727441646a6163656e74456c656d656e7428276265666f7265656e64272c207465737444657461696c446976290a202020202020202020207461726765742e696e7365727441646a6163656e74456c656d656e7428276265666f7265656e64272c20746573744f7574707574446976290a0a2020202020202020202069662028746573745374617475732e50617373656429207b0a202020202020202020202020636f6e736f6c655072652e636c6173734c6973742e72656d6f76652827736b697070656427290a202020202020202020202020636f6e736f6c655072652e636c6173734c6973742e72656d6f766528276661696c656427290a202020202020202020207d20656c73652069662028746573745374617475732e536b697070656429207b0a202020202020202020202020636f6e736f6c655072652e636c6173734c6973742e6164642827736b697070656427290a202020202020202020202020636f6e736f6c655072652e636c6173734c6973742e72656d6f766528276661696c656427290a202020202020202020207d20656c7365207b0a202020202020202020202020636f6e736f6c655072652e636c6173734c6973742e72656d6f76652827736b697070656427290a202020202020202020202020636f6e736f6c655072652e636c6173734c6973742e61646428276661696c656427290a202020202020202020207d0a20202020202020202020617070656e64416e73694f757470757428636f6e736f6c655072652c20746573745374617475732e4f75747075742e6a6f696e28272729290a20202020202020207d20656c7365207b0a20202020202020202020746573744f75747075744469762e72656d6f766528290a20202020202020207d0a2020202020207d0a202020207d2c0a0a202020202f2a2a0a20202020202a204275696c64732074686520737562746573742074726565206f66206120746573742067726f75702066726f6d2074686520222f22207365706172617465642074657374206e616d65732e20506172656e747320707265636564652074686569722073756274657374730a20202020202a20696e207468652072657475726e65642074726565206576656e20696620676f2074657374207265706f72746564207468656d20696e206120646966666572656e74206f726465722e0a20202020202a2040706172616d207b41727261792e3c546573745374617475733e7d2074657374526573756c74730a20202020202a2040706172616d207b66756e6374696f6e2854657374537461747573293a20626f6f6c65616e7d205b696e636c7564655d204966207365742c206f6e6c792074686520696e636c7564656420746573747320616e6420746865697220706172656e747320617265206b6570742e0a20202020202a204072657475726e73207b41727261792e3c54657374547265654e6f64653e7d2054686520746f70206c6576656c2074657374732e0a20202020202a2f0a202020206275696c6454657374547265653a2066756e6374696f6e202874657374526573756c74732c20696e636c75646529207b0a202020202020636f6e737420726f6f7473203d202f2a2a4074797065207b41727261792e3c54657374547265654e6f64653e7d2a2f205b5d0a202020202020636f6e7374206e6f646573427950617468203d206e6577204d617028290a202020202020666f7220286c65742069203d20303b2069203c2074657374526573756c74732e6c656e6774683b20692b2b29207b0a2020202020202020636f6e73742074657374526573756c74203d2074657374526573756c74735b695d0a2020202020202020636f6e7374206e616d6573203d2074657374426173654e616d652874657374526573756c74292e73706c697428272f27290a20202020202020206c6574207369626c696e6773203d20726f6f74730a20202020202020206c65742070617468203d2027270a2020202020202020666f7220286c6574206a203d20303b206a203c206e616d65732e6c656e6774683b206a2b2b29207b0a2020202020202020202070617468203d20286a203d3d3d203029203f206e616d65735b305d203a2060247b706174687d2f247b6e616d65735b6a5d7d600a202020202020202020206c6574206e6f6465203d206e6f6465734279506174682e6765742870617468290a20202020202020202020696620286e6f6465203d3d3d20756e646566696e656429207b0a2020202020202020202020206e6f6465203d207b6e616d653a206e616d65735b6a5d2c20696e6465783a202d312c20746573745374617475733a206e756c6c2c206368696c6472656e3a205b5d2c207061737365643a20302c206661696c65643a20302c20736b69707065643a20302c20656c617073656454696d653a20307d0a2020202020202020202020206e6f6465734279506174682e73657428706174682c206e6f6465290a2020202020202020202020207369626c696e67732e70757368286e6f6465290a202020202020202020207d0a20202020202020202020696620286a203d3d3d206e616d65732e6c656e677468202d203129207b0a2020202020202020202020206e6f64652e696e646578203d20690a2020202020202020202020206e6f64652e74657374537461747573203d2074657374526573756c740a202020202020202020207d0a202020202020202020207369626c696e6773203d206e6f64652e6368696c6472656e0a20202020202020207d0a2020202020207d0a202020202020636f6e73742074726565203d2028696e636c756465203d3d3d20756e646566696e656429203f20726f6f7473203a207072756e65546573745472656528726f6f74732c20696e636c756465290a202020202020747265652e666f72456163682861676772656761746554657374547265654e6f6465290a20202020202072657475726e20747265650a202020207d2c0a0a202020202f2a2a0a20202020202a20496e766f6b6564207768656e2061207573657220636c69636b73206f6e2074686520657870616e642f636f6c6c6170736520746f67676c65206f662061207465737420776974682073756274657374732e0a20202020202a2040706172616d207b456c656d656e747d207461726765742054686520746f67676c6520656c656d656e742e0a20202020202a2f0a2020202074726565546f67676c6548616e646c65723a2066756e6374696f6e202874617267657429207b0a202020202020636f6e7374206368696c6472656e456c656d203d207461726765742e636c6f7365737428272e74657374547265654e6f646527292e717565727953656c6563746f7228273a73636f7065203e202e74657374547265654368696c6472656e27290a202020202020696620286368696c6472656e456c656d20213d206e756c6c29207b0a2020202020202020636f6e737420636f6c6c6170736564203d206368696c6472656e456c656d2e636c6173734c6973742e746f67676c652827636f6c6c617073656427290a20202020202020207461726765742e74657874436f6e74656e74203d20636f6c6c6170736564203f20275c753235623827203a20275c7532356265270a2020202020207d0a202020207d2c0a0a202020202f2a2a0a20202020202a20436f6d70757465732074686520646966666572656e636573206265747765656e2074686520657870656374656420616e642061637475616c2076616c756573206f6620616e20617373657274696f6e2c20776f726420627920776f72642e0a20202020202a2040706172616d207b737472696e677d2065787065637465640a20202020202a2040706172616d207b737472696e677d2061637475616c0a20202020202a204072657475726e73207b7b65787065637465643a2041727261792e3c44696666546f6b656e3e2c2061637475616c3a2041727261792e3c44696666546f6b656e3e7d7d2054686520746f6b656e73206f6620626f74682076616c7565733b2074686520746f6b656e730a20202020202a202020202020202020206d697373696e672066726f6d20746865206f746865722076616c756520617265206d61726b6564206173206368616e6765642e0a20202020202a2f0a2020202064696666417373657274696f6e3a2066756e6374696f6e202865787065637465642c2061637475616c29207b0a202020202020636f6e7374206578706563746564546f6b656e73203d2065787065637465642e6d61746368282f5c772b7c5c732b7c5b5e5c775c735d2f6729207c7c205b5d0a202020202020636f6e73742061637475616c546f6b656e73203d2061637475616c2e6d61746368282f5c772b7c5c732b7c5b5e5c775c735d2f6729207c7c205b5d0a2020202020206c657420707265666978203d20300a2020202020207768696c652028707265666978203c206578706563746564546f6b656e732e6c656e67746820262620707265666978203c2061637475616c546f6b656e732e6c656e6774680a202020202020202020202020202626206578706563746564546f6b656e735b7072656669785d203d3d3d2061637475616c546f6b656e735b7072656669785d29207b0a20202020202020207072656669782b2b0a2020202020207d0a2020202020206c657420737566666978203d20300a2020202020207768696c652028737566666978203c206578706563746564546f6b656e732e6c656e677468202d2070726566697820262620737566666978203c2061637475616c546f6b656e732e6c656e677468202d207072656669780a202020202020202020202020202626206578706563746564546f6b656e735b6578706563746564546f6b656e732e6c656e677468202d2031202d207375666669785d203d3d3d2061637475616c546f6b656e735b61637475616c546f6b656e732e6c656e677468202d2031202d207375666669785d29207b0a20202020202020207375666669782b2b0a2020202020207d0a202020202020636f6e73742065787065637465644d6964646c65203d206578706563746564546f6b656e732e736c696365287072656669782c206578706563746564546f6b656e732e6c656e677468202d20737566666978290a202020202020636f6e73742061637475616c4d6964646c65203d2061637475616c546f6b656e732e736c696365287072656669782c2061637475616c546f6b656e732e6c656e677468202d20737566666978290a202020202020636f6e7374206578706563746564436f6d6d6f6e203d206e65772041727261792865787065637465644d6964646c652e6c656e677468292e66696c6c2866616c7365290a202020202020636f6e73742061637475616c436f6d6d6f6e203d206e65772041727261792861637475616c4d6964646c652e6c656e677468292e66696c6c2866616c7365290a2020202020206966202865787065637465644d6964646c652e6c656e677468202a2061637475616c4d6964646c652e6c656e677468203c3d206d61784469666643656c6c7329207b0a20202020202020202f2f206c6f6e6765737420636f6d6d6f6e2073756273657175656e6365206f662074686520746f6b656e732074686174206469666665720a2020202020202020636f6e7374206c656e67746873203d205b5d0a2020202020202020666f7220286c65742069203d20303b2069203c3d2065787065637465644d6964646c652e6c656e6774683b20692b2b29207b0a202020202020202020206c656e677468732e70757368286e65772041727261792861637475616c4d6964646c652e6c656e677468202b2031292e66696c6c283029290a20202020202020207d0a2020202020202020666f7220286c65742069203d2065787065637465644d6964646c652e6c656e677468202d20313b2069203e3d20303b20692d2d29207b0a20202020202020202020666f7220286c6574206a203d2061637475616c4d6964646c652e6c656e677468202d20313b206a203e3d20303b206a2d2d29207b0a2020202020202020202020206c656e677468735b695d5b6a5d203d202865787065637465644d6964646c655b695d203d3d3d2061637475616c4d6964646c655b6a5d290a20202020202020202020202020203f206c656e677468735b69202b20315d5b6a202b20315d202b20310a20202020202020202020202020203a204d6174682e6d6178286c656e677468735b69202b20315d5b6a5d2c206c656e677468735b695d5b6a202b20315d290a202020202020202020207d0a20202020202020207d0a20202020202020206c65742069203d20300a20202020202020206c6574206a203d20300a20202020202020207768696c65202869203c2065787065637465644d6964646c652e6c656e677468202626206a203c2061637475616c4d6964646c652e6c656e67746829207b0a202020202020202020206966202865787065637465644d6964646c655b695d203d3d3d2061637475616c4d6964646c655b6a5d29207b0a2020202020202020202020206578706563746564436f6d6d6f6e5b692b2b5d203d20747275650a20202020202020202020202061637475616c436f6d6d6f6e5b6a2b2b5d203d20747275650a202020202020202020207d20656c736520696620286c656e677468735b69202b20315d5b6a5d203e3d206c656e677468735b695d5b6a202b20315d29207b0a202020202020202020202020692b2b0a202020202020202020207d20656c7365207b0a2020202020202020202020206a2b2b0a202020202020202020207d0a20202020202020207d0a2020202020207d0a202020202020636f6e737420746f44696666546f6b656e73203d2028746f6b656e732c206d6964646c65436f6d6d6f6e29203d3e20746f6b656e732e6d61702828746578742c206929203d3e20287b0a2020202020202020746578743a20746578742c0a20202020202020206368616e6765643a202869203e3d2070726566697829202626202869203c20746f6b656e732e6c656e677468202d207375666669782920262620216d6964646c65436f6d6d6f6e5b69202d207072656669785d0a2020202020207d29290a20202020202072657475726e207b0a202020202020202065787065637465643a20746f44696666546f6b656e73286578706563746564546f6b656e732c206578706563746564436f6d6d6f6e292c0a202020202020202061637475616c3a20746f44696666546f6b656e732861637475616c546f6b656e732c2061637475616c436f6d6d6f6e290a2020202020207d0a202020207d2c0a0a202020202f2a2a0a20202020202a2053706c69747320746865206f7574707574206f662061207465737420696e746f207365676d656e7473207374796c65642062792074686520414e53492053475220636f64657320697420636f6e7461696e732c20652e672e20225c75303031625b33316d2220666f7220610a20202020202a2072656420666f726567726f756e642e20546865206f74686572206573636170652073657175656e636573206172652064726f707065642e0a20202020202a2040706172616d207b737472696e677d20746578740a20202020202a204072657475726e73207b41727261792e3c416e73695365676d656e743e7d0a20202020202a2f0a202020207061727365416e73694f75747075743a2066756e6374696f6e20287465787429207b0a202020202020636f6e7374207365676d656e7473203d202f2a2a4074797065207b41727261792e3c416e73695365676d656e743e7d2a2f205b5d0a2020202020206c6574207374796c65203d207b636c61737365733a205b5d2c20636f6c6f723a206e756c6c2c206261636b67726f756e64436f6c6f723a206e756c6c7d0a2020202020206c6574206c617374496e646578203d20300a202020202020636f6e7374206164645365676d656e74203d20287365676d656e745465787429203d3e207b0a2020202020202020696620287365676d656e74546578742e6c656e677468203d3d3d203029207b0a2020202020202020202072657475726e0a20202020202020207d0a2020202020202020636f6e7374207365676d656e74203d207b746578743a207365676d656e74546578742c20636c61737365733a207374796c652e636c61737365732e736c69636528297d0a2020202020202020696620287374796c652e636f6c6f7220213d206e756c6c29207b0a202020202020202020207365676d656e742e636f6c6f72203d207374796c652e636f6c6f720a20202020202020207d0a2020202020202020696620287374796c652e6261636b67726f756e64436f6c6f7220213d206e756c6c29207b0a202020202020202020207365676d656e742e6261636b67726f756e64436f6c6f72203d207374796c652e6261636b67726f756e64436f6c6f720a20202020202020207d0a20202020202020207365676d656e74732e70757368287365676d656e74290a2020202020207d0a202020202020616e736945736361706552656765782e6c617374496e646578203d20300a2020202020206c6574206d617463680a2020202020207768696c652028286d61746368203d20616e736945736361706552656765782e657865632874657874292920213d3d206e756c6c29207b0a20202020202020206164645365676d656e7428746578742e737562737472696e67286c617374496e6465782c206d617463682e696e64657829290a20202020202020206c617374496e646578203d20616e736945736361706552656765782e6c617374496e6465780a2020202020202020696620286d617463685b325d203d3d3d20276d2729207b0a202020202020202020207374796c65203d206170706c79536772436f646573287374796c652c206d617463685b315d290a20202020202020207d0a2020202020207d0a2020202020206164645365676d656e7428746578742e737562737472696e67286c617374496e64657829290a20202020202072657475726e207365676d656e74730a202020207d2c0a0a202020202f2a2a0a20202020202a20466f726d61747320616e20485454502063616c6c2061732061206375726c20636f6d6d616e6420726570726f647563696e672069742e0a20202020202a2040706172616d207b417069526571756573747d20726571756573740a20202020202a204072657475726e73207b737472696e677d0a20202020202a2f0a20202020666f726d61744375726c436f6d6d616e643a2066756e6374696f6e20287265717565737429207b0a202020202020636f6e73742071756f7465203d20287465787429203d3e206027247b746578742e7265706c616365282f272f672c2022275c5c272722297d27600a202020202020636f6e7374206d6574686f64203d20726571756573742e4d6574686f64207c7c2027474554270a202020202020636f6e73742061726773203d205b276375726c275d0a202020202020696620286d6574686f6420213d3d202747455427207c7c20726571756573742e426f647929207b0a2020202020202020617267732e7075736828272d58272c206d6574686f64290a2020202020207d0a202020202020617267732e707573682871756f746528726571756573742e55524c207c7c20272729290a2020202020204f626a6563742e6b65797328726571756573742e48656164657273207c7c207b7d292e736f727428292e666f724561636828286e616d6529203d3e20617267732e7075736828272d48272c2071756f74652860247b6e616d657d3a20247b726571756573742e486561646572735b6e616d655d7d602929290a20202020202069662028726571756573742e426f647929207b0a2020202020202020617267732e7075736828272d2d646174612d726177272c2071756f746528726571756573742e426f647929290a2020202020207d0a20202020202072657475726e20617267732e6a6f696e28272027290a202020207d2c0a0a202020202f2a2a0a20202020202a2052657475726e73207472756520696620612074657374206d6174636865732074686520736561726368207465787420616e64207374617475732066696c746572732e0a20202020202a2040706172616d207b546573745374617475737d20746573745374617475730a20202020202a2040706172616d207b5465737446696c7465727d2066696c7465720a20202020202a204072657475726e73207b626f6f6c65616e7d0a20202020202a2f0a202020206d61746368657346696c7465723a2066756e6374696f6e2028746573745374617475732c2066696c74657229207b0a2020202020206966202866696c7465722e73746174757365732e696e6465784f6628746573745374617475734e616d6528746573745374617475732929203c203029207b0a202020202020202072657475726e2066616c73650a2020202020207d0a202020202020636f6e7374207175657279203d2066696c7465722e71756572792e7472696d28292e746f4c6f7765724361736528290a20202020202072657475726e20287175657279203d3d3d20272729207c7c2074657374536561726368546578742874657374537461747573292e696e636c75646573287175657279290a202020207d2c0a0a202020202f2a2a0a20202020202a204170706c69657320612066696c74657220746f20746865207061636b6167652074696c657320616e64207468652074657374206c6973742c20616e6420656e636f64657320697420696e207468652055524c20686173682e0a20202020202a2040706172616d207b5465737446696c7465727d2066696c7465720a20202020202a2f0a202020206170706c7946696c7465723a2066756e6374696f6e202866696c74657229207b0a20202020202066696c7465725374617465203d207b71756572793a2066696c7465722e71756572792c2073746174757365733a2066696c7465722e73746174757365732e736c69636528297d0a202020202020636f6e737420616374697665203d20697346696c74657241637469766528290a202020202020636f6e73742064617461203d20656c656d656e74732e646174610a202020202020656c656d656e74732e74657374526573756c7473456c656d0a20202020202020202020202020202e717565727953656c6563746f72416c6c28272e74657374526573756c7447726f757027290a20202020202020202020202020202e666f72456163682828656c656d29203d3e207b0a20202020202020202020202020202020636f6e7374207465737447726f7570203d20646174615b656c656d2e69645d0a20202020202020202020202020202020636f6e73742076697369626c65203d2021616374697665207c7c20287465737447726f757020213d3d20756e646566696e6564202626207465737447726f75705b2754657374526573756c7473275d20213d3d20756e646566696e65640a2020202020202020202020202020202020202626207465737447726f75705b2754657374526573756c7473275d2e736f6d6528287465737453746174757329203d3e20676f546573745265706f72742e6d61746368657346696c74657228746573745374617475732c2066696c74657253746174652929290a20202020202020202020202020202020656c656d2e636c6173734c6973742e746f67676c65282766696c74657265644f7574272c202176697369626c65290a20202020202020202020202020207d290a2020202020206966202873656c65637465644974656d732e74657374526573756c747320213d206e756c6c29207b0a202020202020202072656e6465725465737447726f75704c69737428646174612c205b73656c65637465644974656d732e74657374526573756c74732e69645d290a2020202020207d20656c7365206966202861637469766529207b0a202020202020202072656e6465725465737447726f75704c69737428646174612c204f626a6563742e6b657973286461746129290a2020202020207d20656c7365207b0a2020202020202020656c656d656e74732e7465737447726f75704c697374456c656d2e74657874436f6e74656e74203d2027270a2020202020207d0a20202020202075706461746546696c746572496e7075747328290a0a202020202020636f6e73742068617368203d20676f546573745265706f72742e666f726d617446696c746572486173682866696c7465725374617465290a2020202020206966202877696e646f772e6c6f636174696f6e2e6861736820213d3d206861736829207b0a202020202020202077696e646f772e686973746f72792e7265706c6163655374617465286e756c6c2c2027272c202868617368203d3d3d20272729203f2077696e646f772e6c6f636174696f6e2e706174686e616d65202b2077696e646f772e6c6f636174696f6e2e736561726368203a2068617368290a2020202020207d0a202020207d2c0a0a202020202f2a2a0a20202020202a20456e636f64657320612066696c74657220617320612055524c20686173682c20652e672e202223713d74696d656f7574267374617475733d6661696c6564223b207468652064656661756c742066696c74657220697320656e636f6465642061732022222e0a20202020202a2040706172616d207b5465737446696c7465727d2066696c7465720a20202020202a204072657475726e73207b737472696e677d0a20202020202a2f0a20202020666f726d617446696c746572486173683a2066756e6374696f6e202866696c74657229207b0a202020202020636f6e737420706172616d73203d206e65772055524c536561726368506172616d7328290a2020202020206966202866696c7465722e717565727920213d3d20272729207b0a2020202020202020706172616d732e736574282771272c2066696c7465722e7175657279290a2020202020207d0a202020202020696620287465737453746174757346696c746572732e736f6d65282873746174757329203d3e2066696c7465722e73746174757365732e696e6465784f662873746174757329203c20302929207b0a2020202020202020706172616d732e7365742827737461747573272c207465737453746174757346696c746572732e66696c746572282873746174757329203d3e2066696c7465722e73746174757365732e696e6465784f662873746174757329203e3d2030292e6a6f696e28272c2729290a2020202020207d0a202020202020636f6e73742068617368203d20706172616d732e746f537472696e6728290a20202020202072657475726e202868617368203d3d3d20272729203f202727203a206023247b686173687d600a202020207d2c0a0a202020202f2a2a0a20202020202a204465636f64657320612055524c2068617368207772697474656e20627920666f726d617446696c746572486173682e0a20202020202a2040706172616d207b737472696e677d20686173680a20202020202a204072657475726e73207b5465737446696c7465727d0a20202020202a2f0a20202020706172736546696c746572486173683a2066756e6374696f6e20286861736829207b0a202020202020636f6e737420706172616d73203d206e65772055524c536561726368506172616d7328686173682e7265706c616365282f5e232f2c20272729290a20202020202072657475726e207b0a202020202020202071756572793a20706172616d732e6765742827712729207c7c2027272c0a202020202020202073746174757365733a20706172616d732e686173282773746174757327290a202020202020202020203f20706172616d732e676574282773746174757327292e73706c697428272c27292e66696c746572282873746174757329203d3e207465737453746174757346696c746572732e696e6465784f662873746174757329203e3d2030290a202020202020202020203a207465737453746174757346696c746572732e736c69636528290a2020202020207d0a202020207d0a20207d0a0a20202f2a2a0a2020202a2052656e64657273207468652073756274657374207472656573206f662074686520676976656e20746573742067726f75707320696e207468652074657374206c6973742c206170706c79696e67207468652063757272656e742066696c7465722e20546865207061636b6167650a2020202a206e616d6520707265636564657320746865207465737473206f6620656163682067726f7570207768656e207365766572616c2067726f757073206172652072656e64657265642e0a2020202a2040706172616d207b54657374526573756c74737d20646174610a2020202a2040706172616d207b41727261792e3c737472696e673e7d207465737447726f75704964730a2020202a2f0a202066756e6374696f6e2072656e6465725465737447726f75704c69737428646174612c207465737447726f757049647329207b0a20202020636f6e7374207465737447726f75704c697374456c656d203d20656c656d656e74732e7465737447726f75704c697374456c656d0a202020207465737447726f75704c697374456c656d2e74657874436f6e74656e74203d2027270a20202020636f6e737420616374697665203d20697346696c74657241637469766528290a202020207465737447726f75704964732e666f724561636828287465737447726f7570496429203d3e207b0a202020202020636f6e73742074657374526573756c7473203d20646174615b7465737447726f757049645d5b2754657374526573756c7473275d0a202020202020636f6e73742074726565203d206163746976650a20202020202020203f20676f546573745265706f72742e6275696c6454657374547265652874657374526573756c74732c20287465737453746174757329203d3e20676f546573745265706f72742e6d61746368657346696c74657228746573745374617475732c2066696c746572537461746529290a20202020202020203a20676f546573745265706f72742e6275696c6454657374547265652874657374526573756c7473290a20202020202069662028747265652e6c656e677468203d3d3d203029207b0a202020202020202072657475726e0a2020202020207d0a202020202020696620287465737447726f75704964732e6c656e677468203e203129207b0a2020202020202020636f6e737420686561646572456c656d203d20646f63756d656e742e637265617465456c656d656e74282764697627290a2020202020202020686561646572456c656d2e636c6173734c6973742e61646428277465737447726f757048656164657227290a2020202020202020686561646572456c656d2e74657874436f6e74656e74203d20646174615b7465737447726f757049645d5b275061636b6167654e616d65275d0a20202020202020207465737447726f75704c697374456c656d2e617070656e644368696c6428686561646572456c656d290a2020202020207d0a202020202020747265652e666f724561636828286e6f646529203d3e207465737447726f75704c697374456c656d2e617070656e644368696c64286372656174655465737454726565456c656d656e74286e6f64652c207465737447726f757049642c206163746976652929290a202020207d290a20207d0a0a20202f2a2a0a2020202a204072657475726e73207b626f6f6c65616e7d205472756520756e6c657373207468652063757272656e742066696c7465722073686f777320616c6c2074657374732e0a2020202a2f0a202066756e6374696f6e20697346696c7465724163746976652829207b0a2020202072657475726e20676f546573745265706f72742e666f726d617446696c746572486173682866696c74657253746174652920213d3d2027270a20207d0a0a20202f2a2a0a2020202a2053796e6368726f6e697a6573207468652073656172636820626f7820616e642073746174757320636865636b626f7865732077697468207468652063757272656e742066696c7465722e0a2020202a2f0a202066756e6374696f6e2075706461746546696c746572496e707574732829207b0a2020202069662028656c656d656e74732e736561726368496e707574456c656d20213d206e756c6c20262620656c656d656e74732e736561726368496e707574456c656d2e76616c756520213d3d2066696c74657253746174652e717565727929207b0a202020202020656c656d656e74732e736561726368496e707574456c656d2e76616c7565203d2066696c74657253746174652e71756572790a202020207d0a2020202069662028656c656d656e74732e73746174757346696c746572456c656d7320213d206e756c6c29207b0a202020202020656c656d656e74732e73746174757346696c746572456c656d732e666f72456163682828656c656d29203d3e0a2020202020202020656c656d2e636865636b6564203d2066696c74657253746174652e73746174757365732e696e6465784f6628656c656d2e6765744174747269627574652827646174612d737461747573272929203e3d2030290a202020207d0a20207d0a0a20202f2a2a0a2020202a205265616473207468652066696c7465722066726f6d207468652073656172636820626f7820616e642073746174757320636865636b626f7865732e0a2020202a204072657475726e73207b5465737446696c7465727d0a2020202a2f0a202066756e6374696f6e207265616446696c746572496e707574732829207b0a20202020636f6e7374207374617475736573203d205b5d0a20202020656c656d656e74732e73746174757346696c746572456c656d732e666f72456163682828656c656d29203d3e207b0a20202020202069662028656c656d2e636865636b656429207b0a202020202020202073746174757365732e7075736828656c656d2e6765744174747269627574652827646174612d7374617475732729290a2020202020207d0a202020207d290a2020202072657475726e207b71756572793a20656c656d656e74732e736561726368496e707574456c656d2e76616c75652c2073746174757365733a2073746174757365737d0a20207d0a0a20202f2a2a0a2020202a2040706172616d207b546573745374617475737d20746573745374617475730a2020202a204072657475726e73207b737472696e677d204f6e65206f66207465737453746174757346696c746572733b20696e74657272757074656420746573747320617265206661696c65642074657374732e0a2020202a2f0a202066756e6374696f6e20746573745374617475734e616d65287465737453746174757329207b0a2020202072657475726e20746573745374617475732e506173736564203f202770617373656427203a2028746573745374617475732e536b6970706564203f2027736b697070656427203a20276661696c656427290a20207d0a0a20202f2a2a0a2020202a2052657475726e7320746865206c6f77657220636173652074657874207365617263686564206279207468652073656172636820626f783a207468652074657374206e616d652c2067756e6974207469746c652c207061636b61676520616e64206f75747075742e0a2020202a2040706172616d207b546573745374617475737d20746573745374617475730a2020202a204072657475726e73207b737472696e677d0a2020202a2f0a202066756e6374696f6e207465737453656172636854657874287465737453746174757329207b0a202020206c65742074657874203d207365617263685465787443616368652e6765742874657374537461747573290a202020206966202874657874203d3d3d20756e646566696e656429207b0a20202020202074657874203d205b746573745374617475732e546573744e616d652c20746573745374617475732e5469746c65207c7c2027272c20746573745374617475732e5061636b6167652c2028746573745374617475732e4f7574707574207c7c205b5d292e6a6f696e282727295d0a20202020202020202e6a6f696e28275c6e27290a20202020202020202e7265706c61636528616e736945736361706552656765782c202727290a20202020202020202e746f4c6f7765724361736528290a2020202020207365617263685465787443616368652e73657428746573745374617475732c2074657874290a202020207d0a2020202072657475726e20746578740a20207d0a0a20202f2a2a0a2020202a204372656174657320746865207369646520627920736964652076696577206f662074686520657870656374656420616e642061637475616c2076616c756573206f6620616e20617373657274696f6e2c2074686520646966666572656e636573206265696e670a2020202a20686967686c6967687465642e0a2020202a2040706172616d207b417373657274696f6e446966667d20617373657274696f6e0a2020202a204072657475726e73207b48544d4c446976456c656d656e747d0a2020202a2f0a202066756e6374696f6e20637265617465417373657274696f6e44696666456c656d656e7428617373657274696f6e29207b0a20202020636f6e73742064696666203d20676f546573745265706f72742e64696666417373657274696f6e28617373657274696f6e2e45787065637465642c20617373657274696f6e2e41637475616c290a20202020636f6e737420617373657274696f6e446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a20202020617373657274696f6e4469762e636c6173734c6973742e6164642827617373657274696f6e4469666627290a20202020636f6e73742063726561746556616c7565456c656d656e74203d2028636c6173734e616d652c206c6162656c2c20746f6b656e732c206368616e6765645461674e616d6529203d3e207b0a202020202020636f6e73742076616c7565446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a20202020202076616c75654469762e636c6173734c6973742e61646428636c6173734e616d65290a202020202020636f6e7374206c6162656c456c656d203d20646f63756d656e742e637265617465456c656d656e7428277370616e27290a2020202020206c6162656c456c656d2e636c6173734c6973742e61646428276c6162656c27290a2020202020206c6162656c456c656d2e74657874436f6e74656e74203d206c6162656c0a202020202020636f6e73742076616c7565507265203d20646f63756d656e742e637265617465456c656d656e74282770726527290a202020202020746f6b656e732e666f72456163682828746f6b656e29203d3e207b0a202020202020202069662028746f6b656e2e6368616e67656429207b0a20202020202020202020636f6e7374206368616e676564456c656d203d20646f63756d656e742e637265617465456c656d656e74286368616e6765645461674e616d65290a202020202020202020206368616e676564456c656d2e74657874436f6e74656e74203d20746f6b656e2e746578740a2020202020202020202076616c75655072652e617070656e644368696c64286368616e676564456c656d290a20202020202020207d20656c7365207b0a2020202020202020202076616c75655072652e617070656e6428746f6b656e2e74657874290a20202020202020207d0a2020202020207d290a20202020202076616c75654469762e617070656e64286c6162656c456c656d2c2076616c7565507265290a20202020202072657475726e2076616c75654469760a202020207d0a20202020617373657274696f6e4469762e617070656e642863726561746556616c7565456c656d656e7428276578706563746564272c20274578706563746564272c20646966662e65787065637465642c202764656c27292c0a20202020202020202020202020202020202020202020202063726561746556616c7565456c656d656e74282761637475616c272c202741637475616c272c20646966662e61637475616c2c2027696e732729290a2020202072657475726e20617373657274696f6e4469760a20207d0a0a20202f2a2a0a2020202a20437265617465732074686520626f6c64206c6162656c206f66206120746573742064657461696c2c20652e672e20225061636b6167653a222e0a2020202a2040706172616d207b737472696e677d206c6162656c0a2020202a204072657475726e73207b48544d4c456c656d656e747d0a2020202a2f0a202066756e6374696f6e206372656174654c6162656c456c656d656e74286c6162656c29207b0a20202020636f6e7374206c6162656c456c656d203d20646f63756d656e742e637265617465456c656d656e7428277374726f6e6727290a202020206c6162656c456c656d2e74657874436f6e74656e74203d206c6162656c0a2020202072657475726e206c6162656c456c656d0a20207d0a0a20202f2a2a0a2020202a20417070656e647320746865206f7574707574206f662061207465737420746f20616e20656c656d656e742c2069747320414e534920636f6c6f7273206265696e67207475726e656420696e746f207374796c6564207370616e732e205468652074657874206973206e657665720a2020202a207061727365642061732048544d4c2e0a2020202a2040706172616d207b48544d4c456c656d656e747d20656c656d0a2020202a2040706172616d207b737472696e677d20746578740a2020202a2f0a202066756e6374696f6e20617070656e64416e73694f757470757428656c656d2c207465787429207b0a2020202069662028746578742e696e6465784f6628275c75303031622729203c203029207b0a202020202020656c656d2e617070656e642874657874290a20202020202072657475726e0a202020207d0a20202020676f546573745265706f72742e7061727365416e73694f75747075742874657874292e666f724561636828287365676d656e7429203d3e207b0a202020202020696620287365676d656e742e636c61737365732e6c656e677468203d3d3d2030202626207365676d656e742e636f6c6f72203d3d206e756c6c202626207365676d656e742e6261636b67726f756e64436f6c6f72203d3d206e756c6c29207b0a2020202020202020656c656d2e617070656e64287365676d656e742e74657874290a202020202020202072657475726e0a2020202020207d0a202020202020636f6e7374207370616e203d20646f63756d656e742e637265617465456c656d656e7428277370616e27290a2020202020207365676d656e742e636c61737365732e666f72456163682828636c6173734e616d6529203d3e207370616e2e636c6173734c6973742e61646428636c6173734e616d6529290a202020202020696620287365676d656e742e636f6c6f7220213d206e756c6c29207b0a20202020202020207370616e2e7374796c652e636f6c6f72203d207365676d656e742e636f6c6f720a2020202020207d0a202020202020696620287365676d656e742e6261636b67726f756e64436f6c6f7220213d206e756c6c29207b0a20202020202020207370616e2e7374796c652e6261636b67726f756e64436f6c6f72203d207365676d656e742e6261636b67726f756e64436f6c6f720a2020202020207d0a2020202020207370616e2e74657874436f6e74656e74203d207365676d656e742e746578740a202020202020656c656d2e617070656e644368696c64287370616e290a202020207d290a20207d0a0a20202f2a2a0a2020202a204170706c6965732074686520706172616d6574657273206f6620616e20534752206573636170652073657175656e63652c20652e672e2022313b3331222c20746f20612074657874207374796c652e0a2020202a2040706172616d207b7b636c61737365733a2041727261792e3c737472696e673e2c20636f6c6f723a203f737472696e672c206261636b67726f756e64436f6c6f723a203f737472696e677d7d207374796c650a2020202a2040706172616d207b737472696e677d20706172616d65746572730a2020202a204072657475726e73207b7b636c61737365733a2041727261792e3c737472696e673e2c20636f6c6f723a203f737472696e672c206261636b67726f756e64436f6c6f723a203f737472696e677d7d20546865206e6577207374796c652e0a2020202a2f0a202066756e6374696f6e206170706c79536772436f646573287374796c652c20706172616d657465727329207b0a20202020636f6e737420636f646573203d2028706172616d6574657273203d3d3d20272729203f205b305d203a20706172616d65746572732e73706c697428273b27292e6d61702828636f646529203d3e207061727365496e7428636f64652c20313029207c7c2030290a20202020636f6e737420726573756c74203d207b636c61737365733a207374796c652e636c61737365732e736c69636528292c20636f6c6f723a207374796c652e636f6c6f722c206261636b67726f756e64436f6c6f723a207374796c652e6261636b67726f756e64436f6c6f727d0a20202020636f6e737420736574436c617373203d20287072656669782c20636c6173734e616d6529203d3e207b0a202020202020726573756c742e636c6173736573203d20726573756c742e636c61737365732e66696c74657228286578697374696e6729203d3e20216578697374696e672e737461727473576974682870726566697829290a20202020202069662028636c6173734e616d6520213d206e756c6c29207b0a2020202020202020726573756c742e636c61737365732e7075736828636c6173734e616d65290a2020202020207d0a202020207d0a20202020636f6e737420736574436f6c6f72203d20286261636b67726f756e642c20636f6c6f72496e6465782c2072676229203d3e207b0a202020202020736574436c617373286261636b67726f756e64203f2027616e73692d62672d27203a2027616e73692d66672d272c2028636f6c6f72496e64657820213d206e756c6c29203f2060616e73692d247b6261636b67726f756e64203f2027626727203a20276667277d2d247b636f6c6f72496e6465787d60203a206e756c6c290a202020202020696620286261636b67726f756e6429207b0a2020202020202020726573756c742e6261636b67726f756e64436f6c6f72203d207267620a2020202020207d20656c7365207b0a2020202020202020726573756c742e636f6c6f72203d207267620a2020202020207d0a202020207d0a20202020666f7220286c65742069203d20303b2069203c20636f6465732e6c656e6774683b20692b2b29207b0a202020202020636f6e737420636f6465203d20636f6465735b695d0a20202020202069662028636f6465203d3d3d203029207b0a2020202020202020726573756c742e636c6173736573203d205b5d0a2020202020202020726573756c742e636f6c6f72203d206e756c6c0a2020202020202020726573756c742e6261636b67726f756e64436f6c6f72203d206e756c6c0a2020202020207d20656c73652069662028636f6465203d3d3d203129207b0a2020202020202020736574436c6173732827616e73692d626f6c64272c2027616e73692d626f6c6427290a2020202020207d20656c73652069662028636f6465203d3d3d203229207b0a2020202020202020736574436c6173732827616e73692d6661696e74272c2027616e73692d6661696e7427290a2020202020207d20656c73652069662028636f6465203d3d3d203329207b0a2020202020202020736574436c6173732827616e73692d6974616c6963272c2027616e73692d6974616c696327290a2020202020207d20656c73652069662028636f6465203d3d3d203429207b0a2020202020202020736574436c6173732827616e73692d756e6465726c696e65272c2027616e73692d756e6465726c696e6527290a2020202020207d20656c73652069662028636f6465203d3d3d20323229207b0a2020202020202020736574436c6173732827616e73692d626f6c64272c206e756c6c290a2020202020202020736574436c6173732827616e73692d6661696e74272c206e756c6c290a2020202020207d20656c73652069662028636f6465203d3d3d20323329207b0a2020202020202020736574436c6173732827616e73692d6974616c6963272c206e756c6c290a2020202020207d20656c73652069662028636f6465203d3d3d20323429207b0a2020202020202020736574436c6173732827616e73692d756e6465726c696e65272c206e756c6c290a2020202020207d20656c7365206966202828636f6465203e3d20333020262620636f6465203c3d20333729207c7c2028636f6465203e3d20343020262620636f6465203c3d2034372929207b0a2020202020202020736574436f6c6f7228636f6465203e3d2034302c20636f646520252031302c206e756c6c290a2020202020207d20656c7365206966202828636f6465203e3d20393020262620636f6465203c3d20393729207c7c2028636f6465203e3d2031303020262620636f6465203c3d203130372929207b0a2020202020202020736574436f6c6f7228636f6465203e3d203130302c20636f64652025203130202b20382c206e756c6c290a2020202020207d20656c73652069662028636f6465203d3d3d203339207c7c20636f6465203d3d3d20343929207b0a2020202020202020736574436f6c6f7228636f6465203d3d3d2034392c206e756c6c2c206e756c6c290a2020202020207d20656c7365206966202828636f6465203d3d3d203338207c7c20636f6465203d3d3d2034382920262620636f6465735b69202b20315d203d3d3d20352026262069202b2032203c20636f6465732e6c656e67746829207b0a2020202020202020636f6e737420636f6c6f72496e646578203d20636f6465735b69202b20325d0a2020202020202020736574436f6c6f7228636f6465203d3d3d2034382c2028636f6c6f72496e646578203c20313629203f20636f6c6f72496e646578203a206e756c6c2c2028636f6c6f72496e646578203c20313629203f206e756c6c203a20616e7369323536436f6c6f7228636f6c6f72496e64657829290a202020202020202069202b3d20320a2020202020207d20656c7365206966202828636f6465203d3d3d203338207c7c20636f6465203d3d3d2034382920262620636f6465735b69202b20315d203d3d3d20322026262069202b2034203c20636f6465732e6c656e67746829207b0a2020202020202020636f6e737420726762203d20636f6465732e736c6963652869202b20322c2069202b2035292e6d6170282876616c756529203d3e204d6174682e6d696e284d6174682e6d61782876616c75652c2030292c2032353529290a2020202020202020736574436f6c6f7228636f6465203d3d3d2034382c206e756c6c2c206072676228247b7267622e6a6f696e28272c27297d2960290a202020202020202069202b3d20340a2020202020207d0a202020207d0a2020202072657475726e20726573756c740a20207d0a0a20202f2a2a0a2020202a2052657475726e732074686520636f6c6f72206f6620616e20696e6465782c2066726f6d20313620746f203235352c206f66207468652032353620636f6c6f722070616c65747465206f66207465726d696e616c732e0a2020202a2040706172616d207b6e756d6265727d20636f6c6f72496e6465780a2020202a204072657475726e73207b737472696e677d0a2020202a2f0a202066756e6374696f6e20616e7369323536436f6c6f7228636f6c6f72496e64657829207b0a2020202069662028636f6c6f72496e646578203e3d2032333229207b0a202020202020636f6e7374206c6576656c203d204d6174682e6d696e2838202b2028636f6c6f72496e646578202d2032333229202a2031302c20323535290a20202020202072657475726e206072676228247b6c6576656c7d2c247b6c6576656c7d2c247b6c6576656c7d29600a202020207d0a20202020636f6e73742063756265496e646578203d20636f6c6f72496e646578202d2031360a2020202072657475726e206072676228247b616e7369436f6c6f724c6576656c735b4d6174682e666c6f6f722863756265496e646578202f203336295d7d2c247b616e7369436f6c6f724c6576656c735b4d6174682e666c6f6f722863756265496e646578202f203629202520365d7d2c247b616e7369436f6c6f724c6576656c735b63756265496e646578202520365d7d29600a20207d0a0a20202f2a2a0a2020202a2043726561746573207468652063617264206f6620616e20485454502063616c6c3a20697473206d6574686f642c2055524c2c2073746174757320616e64206c6174656e63792c207468656e20746865206865616465727320616e6420626f64696573206f662074686520726571756573740a2020202a20616e64206f662074686520726573706f6e73652e0a2020202a2040706172616d207b417069526571756573747d20726571756573740a2020202a204072657475726e73207b48544d4c446976456c656d656e747d0a2020202a2f0a202066756e6374696f6e206372656174655265717565737443617264456c656d656e74287265717565737429207b0a20202020636f6e73742063617264446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a20202020636172644469762e636c6173734c6973742e6164642827726571756573744361726427290a20202020636f6e73742073756d6d617279446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a2020202073756d6d6172794469762e636c6173734c6973742e61646428277265717565737453756d6d61727927290a20202020636f6e73742061646453756d6d6172794974656d203d2028636c6173734e616d652c207465787429203d3e207b0a202020202020636f6e7374206974656d456c656d203d20646f63756d656e742e637265617465456c656d656e7428277370616e27290a2020202020206974656d456c656d2e636c6173734c6973742e61646428636c6173734e616d65290a2020202020206974656d456c656d2e74657874436f6e74656e74203d20746578740a20202020202073756d6d6172794469762e617070656e644368696c64286974656d456c656d290a20202020202072657475726e206974656d456c656d0a202020207d0a2020202061646453756d6d6172794974656d28276d6574686f64272c20726571756573742e4d6574686f64207c7c202747455427290a2020202061646453756d6d6172794974656d282775726c272c20726571756573742e55524c207c7c20726571756573742e4e616d65207c7c202727290a2020202069662028726571756573742e53746174757329207b0a202020202020636f6e737420737461747573456c656d203d2061646453756d6d6172794974656d2827737461747573436f6465272c20726571756573742e537461747573290a202020202020636f6e737420737461747573436f6465203d207061727365496e7428726571756573742e5374617475732c203130290a20202020202069662028737461747573436f6465203e3d2035303029207b0a2020202020202020737461747573456c656d2e636c6173734c6973742e61646428277365727665724572726f7227290a2020202020207d20656c73652069662028737461747573436f6465203e3d2034303029207b0a2020202020202020737461747573456c656d2e636c6173734c6973742e6164642827636c69656e744572726f7227290a2020202020207d20656c73652069662028737461747573436f6465203e3d2032303020262620737461747573436f6465203c2034303029207b0a2020202020202020737461747573456c656d2e636c6173734c6973742e61646428277375636365737327290a2020202020207d0a202020207d0a2020202069662028726571756573742e4c6174656e637929207b0a20202020202061646453756d6d6172794974656d28276c6174656e6379272c20726571756573742e4c6174656e6379290a202020207d0a2020202069662028726571756573742e4e616d6520262620726571756573742e4e616d6520213d3d20726571756573742e55524c29207b0a20202020202061646453756d6d6172794974656d28276170694e616d65272c20726571756573742e4e616d65290a202020207d0a20202020636f6e737420636f7079427574746f6e203d20646f63756d656e742e637265617465456c656d656e742827627574746f6e27290a20202020636f7079427574746f6e2e636c6173734c6973742e6164642827636f70794375726c27290a20202020636f7079427574746f6e2e74657874436f6e74656e74203d2027436f7079206173206375726c270a20202020636f7079427574746f6e2e6164644576656e744c697374656e65722827636c69636b272c202829203d3e20636f70795465787428676f546573745265706f72742e666f726d61744375726c436f6d6d616e642872657175657374292c20636f7079427574746f6e29290a2020202073756d6d6172794469762e617070656e644368696c6428636f7079427574746f6e290a0a20202020636f6e73742063726561746553696465203d2028636c6173734e616d652c207469746c652c20686561646572732c20626f647929203d3e207b0a202020202020636f6e73742073696465446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a202020202020736964654469762e636c6173734c6973742e61646428636c6173734e616d65290a202020202020636f6e7374207469746c65456c656d203d20646f63756d656e742e637265617465456c656d656e74282764697627290a2020202020207469746c65456c656d2e636c6173734c6973742e6164642827736964655469746c6527290a2020202020207469746c65456c656d2e74657874436f6e74656e74203d207469746c650a202020202020736964654469762e617070656e644368696c64287469746c65456c656d290a202020202020636f6e7374206865616465724e616d6573203d204f626a6563742e6b6579732868656164657273207c7c207b7d292e736f727428290a202020202020696620286865616465724e616d65732e6c656e677468203e203029207b0a2020202020202020636f6e737420686561646572735461626c65203d20646f63756d656e742e637265617465456c656d656e7428277461626c6527290a2020202020202020686561646572735461626c652e636c6173734c6973742e61646428276865616465727327290a20202020202020206865616465724e616d65732e666f724561636828286e616d6529203d3e207b0a20202020202020202020636f6e737420726f77203d20646f63756d656e742e637265617465456c656d656e742827747227290a20202020202020202020636f6e7374206e616d65456c656d203d20646f63756d656e742e637265617465456c656d656e742827746427290a202020202020202020206e616d65456c656d2e74657874436f6e74656e74203d206e616d650a20202020202020202020636f6e73742076616c7565456c656d203d20646f63756d656e742e637265617465456c656d656e742827746427290a2020202020202020202076616c7565456c656d2e74657874436f6e74656e74203d20686561646572735b6e616d655d0a20202020202020202020726f772e617070656e64286e616d65456c656d2c2076616c7565456c656d290a20202020202020202020686561646572735461626c652e617070656e644368696c6428726f77290a20202020202020207d290a2020202020202020736964654469762e617070656e644368696c6428686561646572735461626c65290a2020202020207d0a20202020202069662028626f647929207b0a2020202020202020636f6e737420626f6479507265203d20646f63756d656e742e637265617465456c656d656e74282770726527290a2020202020202020626f64795072652e636c6173734c6973742e6164642827626f647927290a2020202020202020626f64795072652e74657874436f6e74656e74203d20666f726d6174426f647928626f6479290a2020202020202020736964654469762e617070656e644368696c6428626f6479507265290a2020202020207d0a20202020202072657475726e20736964654469760a202020207d0a20202020636f6e73742064657461696c73446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a2020202064657461696c734469762e636c6173734c6973742e61646428277265717565737444657461696c7327290a2020202064657461696c734469762e617070656e642863726561746553696465282772657175657374272c202752657175657374272c20726571756573742e486561646572732c20726571756573742e426f6479292c0a20202020202020202020202020202020202020202020637265617465536964652827726573706f6e7365272c2027526573706f6e7365272c20726571756573742e526573706f6e7365486561646572732c20726571756573742e526573706f6e7365426f647929290a20202020636172644469762e617070656e642873756d6d6172794469762c2064657461696c73446976290a2020202072657475726e20636172644469760a20207d0a0a20202f2a2a0a2020202a205072657474792d7072696e7473206120626f6479206966206974206973204a534f4e2e0a2020202a2040706172616d207b737472696e677d20626f64790a2020202a204072657475726e73207b737472696e677d0a2020202a2f0a202066756e6374696f6e20666f726d6174426f647928626f647929207b0a20202020747279207b0a202020202020636f6e73742076616c7565203d204a534f4e2e706172736528626f6479290a2020202020206966202876616c756520213d3d206e756c6c20262620747970656f662076616c7565203d3d3d20276f626a6563742729207b0a202020202020202072657475726e204a534f4e2e737472696e676966792876616c75652c206e756c6c2c2032290a2020202020207d0a202020207d20636174636820286529207b0a2020202020202f2f206e6f74204a534f4e2c2073686f776e2061732069730a202020207d0a2020202072657475726e20626f64790a20207d0a0a20202f2a2a0a2020202a20436f706965732061207465787420746f2074686520636c6970626f6172642c20636f6e6669726d696e6720697420696e20746865206c6162656c206f662074686520627574746f6e2e0a2020202a2040706172616d207b737472696e677d20746578740a2020202a2040706172616d207b48544d4c456c656d656e747d20627574746f6e0a2020202a2f0a202066756e6374696f6e20636f70795465787428746578742c20627574746f6e29207b0a20202020636f6e7374206c6162656c203d20627574746f6e2e74657874436f6e74656e740a20202020636f6e737420636f70696564203d202829203d3e207b0a202020202020627574746f6e2e74657874436f6e74656e74203d2027436f70696564270a20202020202073657454696d656f7574282829203d3e20627574746f6e2e74657874436f6e74656e74203d206c6162656c2c2031353030290a202020207d0a202020206966202877696e646f772e6e6176696761746f722e636c6970626f61726420213d206e756c6c29207b0a20202020202077696e646f772e6e6176696761746f722e636c6970626f6172642e7772697465546578742874657874292e7468656e28636f70696564290a20202020202072657475726e0a202020207d0a202020202f2f20776974686f757420746865206173796e6368726f6e6f757320636c6970626f617264204150492c20652e672e207768656e20746865207265706f7274206973206f70656e65642066726f6d20612066696c6520696e20736f6d652062726f77736572730a20202020636f6e7374207465787441726561203d20646f63756d656e742e637265617465456c656d656e742827746578746172656127290a2020202074657874417265612e76616c7565203d20746578740a20202020646f63756d656e742e626f64792e617070656e644368696c64287465787441726561290a2020202074657874417265612e73656c65637428290a20202020646f63756d656e742e65786563436f6d6d616e642827636f707927290a2020202074657874417265612e72656d6f766528290a20202020636f7069656428290a20207d0a0a20202f2a2a0a2020202a204372656174657320746865207461626c65206f66207468652067756e6974206c6f67207265636f726473206f66206120746573742c20616c6f6e67207769746820636865636b626f7865732066696c746572696e67207468656d206279206c6576656c2e20546865206669656c64730a2020202a206f662061207265636f7264206172652073686f776e207768656e2069747320726f7720697320657870616e6465642e0a2020202a2040706172616d207b41727261792e3c4c6f67456e7472793e7d206c6f67456e74726965730a2020202a204072657475726e73207b48544d4c446976456c656d656e747d0a2020202a2f0a202066756e6374696f6e206372656174654c6f67456e7472696573456c656d656e74286c6f67456e747269657329207b0a20202020636f6e7374206c6f67456e7472696573446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a202020206c6f67456e74726965734469762e636c6173734c6973742e61646428276c6f67456e747269657327290a20202020636f6e7374206c6576656c46696c746572446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a202020206c6576656c46696c7465724469762e636c6173734c6973742e61646428276c6f674c6576656c46696c74657227290a20202020636f6e7374207461626c65203d20646f63756d656e742e637265617465456c656d656e7428277461626c6527290a202020207461626c652e636c6173734c6973742e61646428276c6f675461626c6527290a20202020636f6e737420686561646572526f77203d20646f63756d656e742e637265617465456c656d656e742827747227290a20202020686561646572526f772e617070656e64282e2e2e5b2754696d65272c20274c6576656c272c20274d657373616765275d2e6d6170282868656164657229203d3e207b0a202020202020636f6e737420686561646572456c656d203d20646f63756d656e742e637265617465456c656d656e742827746827290a202020202020686561646572456c656d2e74657874436f6e74656e74203d206865616465720a20202020202072657475726e20686561646572456c656d0a202020207d29290a202020207461626c652e617070656e644368696c6428686561646572526f77290a0a20202020636f6e737420726f777342794c6576656c203d206e6577204d6170286c6f674c6576656c732e6d617028286c6576656c29203d3e205b6c6576656c2c205b5d5d29290a202020206c6f67456e74726965732e666f724561636828286c6f67456e74727929203d3e207b0a202020202020636f6e7374206c6576656c203d206e6f726d616c697a654c6f674c6576656c286c6f67456e7472792e4c6576656c290a202020202020636f6e737420726f77203d20646f63756d656e742e637265617465456c656d656e742827747227290a202020202020726f772e636c6173734c6973742e61646428276c6f67456e74727927290a202020202020636f6e73742074696d65456c656d203d20646f63756d656e742e637265617465456c656d656e742827746427290a20202020202074696d65456c656d2e636c6173734c6973742e61646428276c6f6754696d6527290a202020202020636f6e73742074696d65203d202f54285c647b327d3a5c647b327d3a5c647b327d283f3a5c2e5c647b312c337d293f292f2e65786563286c6f67456e7472792e54696d65207c7c202727290a20202020202074696d65456c656d2e74657874436f6e74656e74203d202874696d6520213d206e756c6c29203f2074696d655b315d203a20286c6f67456e7472792e54696d65207c7c202727290a20202020202074696d65456c656d2e73657441747472696275746528277469746c65272c206c6f67456e7472792e54696d65207c7c202727290a202020202020636f6e7374206c6576656c456c656d203d20646f63756d656e742e637265617465456c656d656e742827746427290a2020202020206c6576656c456c656d2e636c6173734c6973742e61646428276c6f674c6576656c27290a2020202020206c6576656c456c656d2e74657874436f6e74656e74203d206c6f67456e7472792e4c6576656c207c7c2027270a202020202020636f6e7374206d657373616765456c656d203d20646f63756d656e742e637265617465456c656d656e742827746427290a2020202020206d657373616765456c656d2e636c6173734c6973742e61646428276c6f674d65737361676527290a2020202020206d657373616765456c656d2e74657874436f6e74656e74203d206c6f67456e7472792e4d657373616765207c7c2027270a202020202020726f772e617070656e642874696d65456c656d2c206c6576656c456c656d2c206d657373616765456c656d290a2020202020207461626c652e617070656e644368696c6428726f77290a202020202020636f6e7374206c6576656c526f7773203d205b726f775d0a0a202020202020636f6e7374206669656c644e616d6573203d204f626a6563742e6b657973286c6f67456e7472792e4669656c6473207c7c207b7d290a202020202020696620286669656c644e616d65732e6c656e677468203e203029207b0a2020202020202020636f6e7374206669656c6473526f77203d20646f63756d656e742e637265617465456c656d656e742827747227290a20202020202020206669656c6473526f772e636c6173734c6973742e61646428276c6f674669656c6473272c2027636f6c6c617073656427290a2020202020202020636f6e7374206669656c647343656c6c203d20646f63756d656e742e637265617465456c656d656e742827746427290a20202020202020206669656c647343656c6c2e7365744174747269627574652827636f6c7370616e272c20273327290a2020202020202020636f6e7374206669656c6473507265203d20646f63756d656e742e637265617465456c656d656e74282770726527290a20202020202020206669656c64735072652e74657874436f6e74656e74203d204a534f4e2e737472696e67696679286c6f67456e7472792e4669656c64732c206e756c6c2c2032290a20202020202020206669656c647343656c6c2e617070656e644368696c64286669656c6473507265290a20202020202020206669656c6473526f772e617070656e644368696c64286669656c647343656c6c290a20202020202020207461626c652e617070656e644368696c64286669656c6473526f77290a20202020202020206c6576656c526f77732e70757368286669656c6473526f77290a0a2020202020202020636f6e7374206669656c6473546f67676c65203d20646f63756d656e742e637265617465456c656d656e742827627574746f6e27290a20202020202020206669656c6473546f67676c652e636c6173734c6973742e61646428276669656c6473546f67676c6527290a20202020202020206669656c6473546f67676c652e74657874436f6e74656e74203d20605c753235623820247b6669656c644e616d65732e6c656e6774687d206669656c64287329600a20202020202020206669656c6473546f67676c652e6164644576656e744c697374656e65722827636c69636b272c202829203d3e207b0a20202020202020202020636f6e737420636f6c6c6170736564203d206669656c6473526f772e636c6173734c6973742e746f67676c652827636f6c6c617073656427290a202020202020202020206669656c6473546f67676c652e74657874436f6e74656e74203d2060247b636f6c6c6170736564203f20275c753235623827203a20275c7532356265277d20247b6669656c644e616d65732e6c656e6774687d206669656c64287329600a20202020202020207d290a20202020202020206d657373616765456c656d2e617070656e64282720272c206669656c6473546f67676c65290a2020202020207d0a202020202020696620286c6576656c20213d3d20272729207b0a2020202020202020726f772e636c6173734c6973742e616464286c6576656c290a2020202020202020726f777342794c6576656c2e736574286c6576656c2c20726f777342794c6576656c2e676574286c6576656c292e636f6e636174286c6576656c526f777329290a2020202020207d0a202020207d290a0a202020206c6f674c6576656c732e666f724561636828286c6576656c29203d3e207b0a202020202020636f6e737420636f756e74203d206c6f67456e74726965732e66696c74657228286c6f67456e74727929203d3e206e6f726d616c697a654c6f674c6576656c286c6f67456e7472792e4c6576656c29203d3d3d206c6576656c292e6c656e6774680a202020202020636f6e7374206c6162656c203d20646f63756d656e742e637265617465456c656d656e7428276c6162656c27290a202020202020636f6e737420636865636b626f78203d20646f63756d656e742e637265617465456c656d656e742827696e70757427290a202020202020636865636b626f782e736574417474726962757465282774797065272c2027636865636b626f7827290a202020202020636865636b626f782e7365744174747269627574652827646174612d6c6576656c272c206c6576656c290a202020202020636865636b626f782e636865636b6564203d20747275650a202020202020636865636b626f782e6164644576656e744c697374656e657228276368616e6765272c202829203d3e0a2020202020202020726f777342794c6576656c2e676574286c6576656c292e666f72456163682828726f7729203d3e20726f772e636c6173734c6973742e746f67676c65282766696c74657265644f7574272c2021636865636b626f782e636865636b65642929290a2020202020206c6162656c2e617070656e6428636865636b626f782c206020247b6c6576656c7d2028247b636f756e747d2960290a2020202020206c6576656c46696c7465724469762e617070656e644368696c64286c6162656c290a202020207d290a202020206c6f67456e74726965734469762e617070656e64286c6576656c46696c7465724469762c207461626c65290a2020202072657475726e206c6f67456e74726965734469760a20207d0a0a20202f2a2a0a2020202a204d61707320746865206c6576656c206f662061206c6f67207265636f726420746f206f6e65206f6620746865206c6576656c73206f66207468652066696c7465722c206f7220222220696620697420697320756e6b6e6f776e2c20696e2077686963682063617365207468650a2020202a207265636f7264206973206e657665722066696c7465726564206f75742e0a2020202a2040706172616d207b737472696e677d206c6576656c0a2020202a204072657475726e73207b737472696e677d0a2020202a2f0a202066756e6374696f6e206e6f726d616c697a654c6f674c6576656c286c6576656c29207b0a20202020636f6e7374206e616d65203d20286c6576656c207c7c202727292e746f4c6f7765724361736528290a20202020696620286e616d65203d3d3d2027747261636527207c7c206e616d65203d3d3d202764656275672729207b0a20202020202072657475726e20276465627567270a202020207d20656c736520696620286e616d65203d3d3d2027696e666f2729207b0a20202020202072657475726e2027696e666f270a202020207d20656c736520696620286e616d65203d3d3d20277761726e27207c7c206e616d65203d3d3d20277761726e696e672729207b0a20202020202072657475726e20277761726e270a202020207d20656c736520696620285b276572726f72272c2027657272272c20276470616e6963272c202770616e6963272c2027666174616c275d2e696e6465784f66286e616d6529203e3d203029207b0a20202020202072657475726e20276572726f72270a202020207d0a2020202072657475726e2027270a20207d0a0a20202f2a2a0a2020202a20437265617465732074686520627574746f6e2073686f77696e67206f7220686964696e672074686520726177206f7574707574206f66206120746573742c20776869636820697320696e697469616c6c792068696464656e2e0a2020202a2040706172616d207b48544d4c456c656d656e747d20636f6e736f6c655072650a2020202a204072657475726e73207b48544d4c427574746f6e456c656d656e747d0a2020202a2f0a202066756e6374696f6e206372656174655261774f7574707574546f67676c6528636f6e736f6c6550726529207b0a20202020636f6e737420746f67676c65427574746f6e203d20646f63756d656e742e637265617465456c656d656e742827627574746f6e27290a20202020746f67676c65427574746f6e2e636c6173734c6973742e61646428277261774f7574707574546f67676c6527290a20202020746f67676c65427574746f6e2e74657874436f6e74656e74203d202753686f7720726177206f7574707574270a20202020636f6e736f6c655072652e636c6173734c6973742e616464282768696464656e27290a20202020746f67676c65427574746f6e2e6164644576656e744c697374656e65722827636c69636b272c202829203d3e207b0a202020202020636f6e73742068696464656e203d20636f6e736f6c655072652e636c6173734c6973742e746f67676c65282768696464656e27290a202020202020746f67676c65427574746f6e2e74657874436f6e74656e74203d2068696464656e203f202753686f7720726177206f757470757427203a20274869646520726177206f7574707574270a202020207d290a2020202072657475726e20746f67676c65427574746f6e0a20207d0a0a20202f2a2a0a2020202a20437265617465732074686520686973746f72792064657461696c73206f66206120746573743a206120737061726b6c696e65206f6620697473206475726174696f6e73206f76657220746865206c6173742072756e732c207768657265206661696c65642072756e73206172650a2020202a206d61726b6564207265642c20616e64207768656e206974207374617274656420746f206661696c2e0a2020202a2040706172616d207b54657374486973746f72797d20686973746f72790a2020202a204072657475726e73207b48544d4c446976456c656d656e747d0a2020202a2f0a202066756e6374696f6e2063726561746554657374486973746f7279456c656d656e7428686973746f727929207b0a20202020636f6e737420686973746f7279446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a20202020686973746f72794469762e636c6173734c6973742e6164642827686973746f727927290a20202020686973746f72794469762e617070656e644368696c64286372656174654c6162656c456c656d656e7428274475726174696f6e207472656e643a2729290a0a20202020636f6e7374207769647468203d203132300a20202020636f6e737420686569676874203d2032300a20202020636f6e7374206475726174696f6e73203d20686973746f72792e4475726174696f6e732e66696c74657228286475726174696f6e29203d3e206475726174696f6e20213d206e756c6c290a20202020636f6e7374206d61784475726174696f6e203d204d6174682e6d6178282e2e2e6475726174696f6e732c2030290a20202020636f6e73742073746570203d2028686973746f72792e4475726174696f6e732e6c656e677468203e203129203f207769647468202f2028686973746f72792e4475726174696f6e732e6c656e677468202d203129203a20300a20202020636f6e7374207376674e616d657370616365203d2027687474703a2f2f7777772e77332e6f72672f323030302f737667270a20202020636f6e737420737667203d20646f63756d656e742e637265617465456c656d656e744e53287376674e616d6573706163652c202773766727290a202020207376672e73657441747472696275746528277769647468272c2077696474682e746f537472696e672829290a202020207376672e7365744174747269627574652827686569676874272c206865696768742e746f537472696e672829290a20202020636f6e737420706f696e7473203d205b5d0a20202020686973746f72792e4475726174696f6e732e666f724561636828286475726174696f6e2c206929203d3e207b0a202020202020696620286475726174696f6e203d3d206e756c6c29207b0a202020202020202072657475726e0a2020202020207d0a202020202020636f6e73742078203d202869202a2073746570292e746f46697865642831290a202020202020636f6e73742079203d2028286d61784475726174696f6e203e203029203f20686569676874202d2032202d20286475726174696f6e202f206d61784475726174696f6e29202a2028686569676874202d203429203a20686569676874202f2032292e746f46697865642831290a202020202020706f696e74732e707573682860247b787d2c247b797d60290a20202020202069662028686973746f72792e53746174757365735b695d203d3d3d20276661696c65642729207b0a2020202020202020636f6e737420636972636c65203d20646f63756d656e742e637265617465456c656d656e744e53287376674e616d6573706163652c2027636972636c6527290a2020202020202020636972636c652e7365744174747269627574652827636c617373272c20276661696c656427290a2020202020202020636972636c652e73657441747472696275746528276378272c2078290a2020202020202020636972636c652e73657441747472696275746528276379272c2079290a2020202020202020636972636c652e736574417474726962757465282772272c20273227290a20202020202020207376672e617070656e644368696c6428636972636c65290a2020202020207d0a202020207d290a20202020636f6e737420706f6c796c696e65203d20646f63756d656e742e637265617465456c656d656e744e53287376674e616d6573706163652c2027706f6c796c696e6527290a20202020706f6c796c696e652e7365744174747269627574652827706f696e7473272c20706f696e74732e6a6f696e2827202729290a202020207376672e696e736572744265666f726528706f6c796c696e652c207376672e66697273744368696c64290a20202020686973746f72794469762e617070656e644368696c6428737667290a20202020686973746f72794469762e617070656e642860247b6475726174696f6e732e6c656e6774687d206f6620247b686973746f72792e4475726174696f6e732e6c656e6774687d2072756e2873292c206d617820247b6d61784475726174696f6e7d7360290a0a2020202069662028686973746f72792e46697273744661696c6564417429207b0a202020202020636f6e73742066697273744661696c6564446976203d20646f63756d656e742e637265617465456c656d656e74282764697627290a20202020202066697273744661696c65644469762e636c6173734c6973742e616464282766697273744661696c656427290a20202020202066697273744661696c65644469762e617070656e64286372656174654c6162656c456c656d656e7428274669727374206661696c65642061743a27292c206020247b686973746f72792e46697273744661696c656441747d60290a20202020202069662028686973746f72792e46697273744661696c6564436f6d6d697429207b0a202020202020202066697273744661696c65644469762e617070656e6428602028247b686973746f72792e46697273744661696c6564436f6d6d69747d2960290a2020202020207d0a202020202020686973746f72794469762e617070656e644368696c642866697273744661696c6564446976290a202020207d0a2020202072657475726e20686973746f72794469760a20207d0a0a20202f2a2a0a2020202a2052656d6f76657320746865207465737473207468617420617265206e6f7420696e636c7564656420616e642068617665206e6f20696e636c756465642073756274657374732e0a2020202a2040706172616d207b41727261792e3c54657374547265654e6f64653e7d206e6f6465730a2020202a2040706172616d207b66756e6374696f6e2854657374537461747573293a20626f6f6c65616e7d20696e636c7564650a2020202a204072657475726e73207b41727261792e3c54657374547265654e6f64653e7d0a2020202a2f0a202066756e6374696f6e207072756e655465737454726565286e6f6465732c20696e636c75646529207b0a2020202072657475726e206e6f6465732e66696c74657228286e6f646529203d3e207b0a2020202020206e6f64652e6368696c6472656e203d207072756e655465737454726565286e6f64652e6368696c6472656e2c20696e636c756465290a20202020202072657475726e206e6f64652e6368696c6472656e2e6c656e677468203e2030207c7c20286e6f64652e7465737453746174757320213d206e756c6c20262620696e636c756465286e6f64652e7465737453746174757329290a202020207d290a20207d0a0a20202f2a2a0a2020202a2052657475726e73207468652074657374206e616d6520776974686f7574207468652067756e6974207469746c652c20652e672e202254657374466f6f2f43617365312220666f72202254657374466f6f2f4361736531287469746c6529222e0a2020202a2040706172616d207b546573745374617475737d20746573745374617475730a2020202a204072657475726e73207b737472696e677d0a2020202a2f0a202066756e6374696f6e2074657374426173654e616d65287465737453746174757329207b0a20202020636f6e737420737566666978203d206028247b746573745374617475732e5469746c657d29600a2020202069662028746573745374617475732e5469746c6520262620746573745374617475732e546573744e616d652e656e647357697468287375666669782929207b0a20202020202072657475726e20746573745374617475732e546573744e616d652e737562737472696e6728302c20746573745374617475732e546573744e616d652e6c656e677468202d207375666669782e6c656e677468290a202020207d0a2020202072657475726e20746573745374617475732e546573744e616d650a20207d0a0a20202f2a2a0a2020202a20436f6d70757465732074686520706173732f6661696c2f736b697020636f756e747320616e6420746865206475726174696f6e206f6620612074726565206e6f64652066726f6d20697473206f776e20726573756c7420616e64206974732073756274657374732e0a2020202a204f6d697474656420706172656e747320617265206f6e6c7920636f756e746564207468726f7567682074686569722073756274657374732c20617320696e2074686520686561646572206f6620746865207265706f72742e0a2020202a2040706172616d207b54657374547265654e6f64657d206e6f64650a2020202a2f0a202066756e6374696f6e2061676772656761746554657374547265654e6f6465286e6f646529207b0a202020206e6f64652e6368696c6472656e2e666f72456163682861676772656761746554657374547265654e6f6465290a20202020636f6e73742074657374537461747573203d206e6f64652e746573745374617475730a20202020696620287465737453746174757320213d206e756c6c20262620746573745374617475732e4f6d697474656420213d3d207472756529207b0a20202020202069662028746573745374617475732e50617373656429207b0a20202020202020206e6f64652e706173736564202b3d20310a2020202020207d20656c73652069662028746573745374617475732e536b697070656429207b0a20202020202020206e6f64652e736b6970706564202b3d20310a2020202020207d20656c7365207b0a20202020202020206e6f64652e6661696c6564202b3d20310a2020202020207d0a202020207d0a202020206c6574206368696c6472656e456c617073656454696d65203d20300a202020206e6f64652e6368696c6472656e2e666f724561636828286368696c6429203d3e207b0a2020202020206e6f64652e706173736564202b3d206368696c642e7061737365640a2020202020206e6f64652e6661696c6564202b3d206368696c642e6661696c65640a2020202020206e6f64652e736b6970706564202b3d206368696c642e736b69707065640a2020202020206368696c6472656e456c617073656454696d65202b3d206368696c642e656c617073656454696d650a202020207d290a202020202f2f20746865206475726174696f6e206f66206120706172656e74207465737420616c726561647920696e636c7564657320746865206475726174696f6e206f66206974732073756274657374730a202020206e6f64652e656c617073656454696d65203d20287465737453746174757320213d206e756c6c29203f20746573745374617475732e456c617073656454696d65203a206368696c6472656e456c617073656454696d650a20207d0a0a20202f2a2a0a2020202a2052657475726e7320746865207374617475732043535320636c6173736573206f6620612074726565206e6f64653b2061206e6f646520776974686f7574206120726573756c74206f6620697473206f776e20676574732074686520776f72737420737461747573206f660a2020202a206974732073756274657374732e0a2020202a2040706172616d207b54657374547265654e6f64657d206e6f64650a2020202a204072657475726e73207b737472696e677d0a2020202a2f0a202066756e6374696f6e2074657374547265654e6f6465537461747573286e6f646529207b0a20202020636f6e73742074657374537461747573203d206e6f64652e746573745374617475730a202020206966202874657374537461747573203d3d206e756c6c29207b0a20202020202072657475726e20286e6f64652e6661696c6564203e203029203f20276661696c656427203a2028286e6f64652e706173736564203d3d3d2030202626206e6f64652e736b6970706564203e203029203f2027736b697070656427203a202727290a202020207d0a2020202069662028746573745374617475732e50617373656429207b0a20202020202072657475726e2027270a202020207d0a2020202069662028746573745374617475732e536b697070656429207b0a20202020202072657475726e2027736b6970706564270a202020207d0a2020202072657475726e2028746573745374617475732e496e746572727570746564203d3d3d207472756529203f20276661696c656420696e74657272757074656427203a20276661696c6564270a20207d0a0a20202f2a2a0a2020202a20437265617465732074686520656c656d656e74206f6620612074726565206e6f64653a20697473207465737420726f772c20666f6c6c6f7765642062792074686520636f6c6c61707369626c65206c697374206f66206974732073756274657374732e2053756274726565730a2020202a20776974686f7574206661696c757265732061726520636f6c6c617073656420696e697469616c6c792c20756e6c65737320657870616e646564206973207365742e0a2020202a2040706172616d207b54657374547265654e6f64657d206e6f64650a2020202a2040706172616d207b737472696e677d2074657374496420546865206964206f662074686520746573742067726f75702e0a2020202a2040706172616d207b626f6f6c65616e7d20657870616e6465640a2020202a204072657475726e73207b48544d4c446976456c656d656e747d0a2020202a2f0a202066756e6374696f6e206372656174655465737454726565456c656d656e74286e6f64652c207465737449642c20657870616e64656429207b0a20202020636f6e737420737461747573203d2074657374547265654e6f6465537461747573286e6f6465290a20202020636f6e7374206e6f6465456c656d203d20646f63756d656e742e637265617465456c656d656e74282764697627290a202020206e6f6465456c656d2e636c6173734c6973742e616464282774657374547265654e6f646527290a20202020636f6e737420726f77456c656d203d20646f63756d656e742e637265617465456c656d656e74282764697627290a20202020726f77456c656d2e636c6173734e616d65203d20607465737447726f7570526f7720247b7374617475737d602e7472696d28290a20202020696620286e6f64652e696e646578203e3d203029207b0a202020202020726f77456c656d2e7365744174747269627574652827646174612d67726f75706964272c20746573744964290a202020202020726f77456c656d2e7365744174747269627574652827646174612d696e646578272c206e6f64652e696e6465782e746f537472696e672829290a202020207d0a20202020636f6e737420737461747573456c656d203d20646f63756d656e742e637265617465456c656d656e7428277370616e27290a20202020737461747573456c656d2e636c6173734e616d65203d20607465737453746174757320247b7374617475737d602e7472696d28290a20202020737461747573456c656d2e74657874436f6e74656e74203d2028737461747573203d3d3d20272729203f20275c753237313327203a202828737461747573203d3d3d2027736b69707065642729203f20275c753230313027203a202828737461747573203d3d3d20276661696c65642729203f20275c753237313727203a20275c75323661302729290a20202020636f6e737420746f67676c65456c656d203d20646f63756d656e742e637265617465456c656d656e7428277370616e27290a20202020746f67676c65456c656d2e636c6173734c6973742e616464282774726565546f67676c6527290a20202020636f6e7374207469746c65456c656d203d20646f63756d656e742e637265617465456c656d656e7428277370616e27290a202020207469746c65456c656d2e636c6173734c6973742e6164642827746573745469746c6527290a202020207469746c65456c656d2e74657874436f6e74656e74203d20286e6f64652e7465737453746174757320213d206e756c6c202626206e6f64652e746573745374617475732e5469746c6529203f2060247b6e6f64652e6e616d657d28247b6e6f64652e746573745374617475732e5469746c657d2960203a206e6f64652e6e616d650a20202020696620286e6f64652e7465737453746174757320213d206e756c6c202626206e6f64652e746573745374617475732e466c616b79203d3d3d207472756529207b0a202020202020636f6e737420666c616b79456c656d203d20646f63756d656e742e637265617465456c656d656e7428277370616e27290a202020202020666c616b79456c656d2e636c6173734c6973742e6164642827666c616b79426164676527290a202020202020666c616b79456c656d2e74657874436f6e74656e74203d2027666c616b79270a2020202020207469746c65456c656d2e617070656e644368696c6428666c616b79456c656d290a202020207d0a20202020636f6e7374206475726174696f6e456c656d203d20646f63756d656e742e637265617465456c656d656e7428277370616e27290a202020206475726174696f6e456c656d2e636c6173734c6973742e6164642827746573744475726174696f6e27290a20202020636f6e737420656c617073656454696d65456c656d203d20646f63756d656e742e637265617465456c656d656e7428277370616e27290a20202020656c617073656454696d65456c656d2e74657874436f6e74656e74203d2060247b6e6f64652e656c617073656454696d657d7320600a202020206475726174696f6e456c656d2e617070656e6428656c617073656454696d65456c656d2c20275c753233663127290a20202020726f77456c656d2e617070656e6428737461747573456c656d2c20746f67676c65456c656d2c207469746c65456c656d2c206475726174696f6e456c656d290a202020206e6f6465456c656d2e617070656e644368696c6428726f77456c656d290a0a20202020696620286e6f64652e6368696c6472656e2e6c656e677468203e203029207b0a202020202020636f6e737420636f756e7473456c656d203d20646f63756d656e742e637265617465456c656d656e7428277370616e27290a202020202020636f756e7473456c656d2e636c6173734c6973742e616464282774657374436f756e747327290a202020202020636f756e7473456c656d2e74657874436f6e74656e74203d20605c7532373133247b6e6f64652e7061737365647d205c7532373137247b6e6f64652e6661696c65647d205c7532303130247b6e6f64652e736b69707065647d20600a2020202020206475726174696f6e456c656d2e696e7365727441646a6163656e74456c656d656e7428276166746572626567696e272c20636f756e7473456c656d290a202020202020636f6e7374206368696c6472656e456c656d203d20646f63756d656e742e637265617465456c656d656e74282764697627290a2020202020206368696c6472656e456c656d2e636c6173734c6973742e616464282774657374547265654368696c6472656e27290a202020202020696620286e6f64652e6661696c6564203d3d3d20302026262021657870616e64656429207b0a20202020202020206368696c6472656e456c656d2e636c6173734c6973742e6164642827636f6c6c617073656427290a2020202020207d0a202020202020746f67676c65456c656d2e74657874436f6e74656e74203d206368696c6472656e456c656d2e636c6173734c6973742e636f6e7461696e732827636f6c6c61707365642729203f20275c753235623827203a20275c7532356265270a2020202020206e6f64652e6368696c6472656e2e666f724561636828286368696c6429203d3e206368696c6472656e456c656d2e617070656e644368696c64286372656174655465737454726565456c656d656e74286368696c642c207465737449642c20657870616e6465642929290a2020202020206e6f6465456c656d2e617070656e644368696c64286368696c6472656e456c656d290a202020207d0a2020202072657475726e206e6f6465456c656d0a20207d0a0a20202f2f2b2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2b0a20202f2f7c20202020736574757020444f4d206576656e7473202020207c0a20202f2f2b2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2d2b0a2020656c656d656e74732e74657374526573756c7473456c656d0a202020202020202020202e6164644576656e744c697374656e65722827636c69636b272c206576656e74203d3e0a202020202020202020202020676f546573745265706f72742e74657374526573756c7473436c69636b48616e646c6572282f2a2a4074797065207b48544d4c456c656d656e747d2a2f206164644576656e7444617461286576656e74292e646174612e7461726765742c0a202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020206576656e742e73686966744b65792c0a20202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020656c656d656e74732e646174612c0a2020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202073656c65637465644974656d732c0a20202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020676f546573745265706f72742e7465737447726f75704c69737448616e646c657229290a0a2020656c656d656e74732e7465737447726f75704c697374456c656d0a202020202020202020202e6164644576656e744c697374656e65722827636c69636b272c206576656e74203d3e207b0a202020202020202020202020696620286576656e742e7461726765742e636c6173734c6973742e636f6e7461696e73282774726565546f67676c65272929207b0a2020202020202020202020202020676f546573745265706f72742e74726565546f67676c6548616e646c6572282f2a2a4074797065207b456c656d656e747d2a2f206576656e742e746172676574290a2020202020202020202020207d20656c7365207b0a2020202020202020202020202020676f546573745265706f72742e7465737447726f75704c69737448616e646c6572282f2a2a4074797065207b456c656d656e747d2a2f206576656e742e7461726765742c0a202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020202020656c656d656e74732e64617461290a2020202020202020202020207d0a202020202020202020207d290a0a202069662028656c656d656e74732e736561726368496e707574456c656d20213d206e756c6c20262620656c656d656e74732e73746174757346696c746572456c656d7320213d206e756c6c29207b0a20202020636f6e73742066696c7465724368616e676564203d202829203d3e20676f546573745265706f72742e6170706c7946696c746572287265616446696c746572496e707574732829290a20202020656c656d656e74732e736561726368496e707574456c656d2e6164644576656e744c697374656e65722827696e707574272c2066696c7465724368616e676564290a20202020656c656d656e74732e73746174757346696c746572456c656d732e666f72456163682828656c656d29203d3e20656c656d2e6164644576656e744c697374656e657228276368616e6765272c2066696c7465724368616e67656429290a20207d0a202077696e646f772e6164644576656e744c697374656e65722827686173686368616e6765272c202829203d3e0a20202020676f546573745265706f72742e6170706c7946696c74657228676f546573745265706f72742e706172736546696c746572486173682877696e646f772e6c6f636174696f6e2e686173682929290a20206966202877696e646f772e6c6f636174696f6e2e6861736820213d3d20272729207b0a20202020676f546573745265706f72742e6170706c7946696c74657228676f546573745265706f72742e706172736546696c746572486173682877696e646f772e6c6f636174696f6e2e6861736829290a20207d0a0a202072657475726e20676f546573745265706f72740a7d0a`
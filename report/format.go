@@ -0,0 +1,192 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/smarty/gunit"
+	"sort"
+	"strings"
+	"time"
+)
+
+type testOutput struct {
+	isJson  bool
+	line    string
+	jsonObj map[string]interface{}
+	// logTime is the time of a gunit JSON log object.
+	logTime time.Time
+}
+type testOutputStatus struct {
+	output testOutput
+	time   time.Time
+}
+
+func (s testOutputStatus) Less(other testOutputStatus) bool {
+	return s.time.Before(other.time)
+}
+
+// FormatTests moves the gunit JSON log objects to the tests they belong to, applies the gunit titles, and redacts
+// the secrets of the output with the given redactor (nil to keep the output as is).
+func FormatTests(allTests map[string]*TestStatus, redactor *Redactor) (map[string]*TestStatus, map[string]map[string]*TestStatus) {
+
+	testsOutputs := make(map[string][]testOutputStatus)
+	testTitles := make(map[string]string)
+	for key, status := range allTests {
+		if _, ok := testsOutputs[key]; !ok {
+			testsOutputs[key] = make([]testOutputStatus, 0)
+		}
+		outputLine := ""
+		var lineTime time.Time
+		for i, output := range status.Output {
+			if outputLine == "" && i < len(status.outputTimes) && !status.outputTimes[i].IsZero() {
+				// a line split over several events has the time of its first part; lines without time keep the
+				// time of the previous line
+				lineTime = status.outputTimes[i]
+			}
+			outputLine += output
+			if !strings.HasSuffix(outputLine, "\n") {
+				continue
+			} else {
+				jsonStr := strings.TrimSpace(outputLine)
+				var jsonObj map[string]interface{}
+				err := json.Unmarshal([]byte(jsonStr), &jsonObj)
+				o := testOutput{
+					isJson: false,
+					line:   redactor.redactText(outputLine),
+				}
+				out := testOutputStatus{
+					output: o,
+					time:   lineTime,
+				}
+				if err != nil {
+					testsOutputs[key] = append(testsOutputs[key], out)
+				} else {
+					testName, foundTest := jsonObj[gunit.Test]
+					packageName, foundPackage := jsonObj[gunit.Package]
+					if foundTest && foundPackage {
+
+						delete(jsonObj, gunit.Test)
+						delete(jsonObj, gunit.Package)
+						logTime, _ := jsonObj["time"].(string)
+
+						t, _ := time.Parse(time.RFC3339Nano, logTime)
+						o = testOutput{
+							isJson:  true,
+							jsonObj: jsonObj,
+							logTime: t,
+						}
+						out = testOutputStatus{
+							output: o,
+							time:   lineTime,
+						}
+						// the time of the event keeps the order in which the lines were written, unlike the time of
+						// the log, which may be less precise
+						if out.time.IsZero() {
+							out.time = t
+						}
+						if packageName != "" {
+							newKey := packageName.(string) + "." + filterTestName(testName.(string))
+							if _, ok := testsOutputs[newKey]; !ok {
+								testsOutputs[newKey] = make([]testOutputStatus, 0)
+							}
+							testsOutputs[newKey] = append(testsOutputs[newKey], out)
+						} else {
+							testsOutputs[key] = append(testsOutputs[key], out)
+						}
+					} else {
+						testsOutputs[key] = append(testsOutputs[key], out)
+					}
+				}
+				outputLine = ""
+			}
+		}
+	}
+	newAllTests := make(map[string]*TestStatus)
+	testsInPackages := make(map[string]map[string]*TestStatus)
+
+	genOutputs := func(key string, outputStatus []testOutputStatus) ([]string, []*LogEntry) {
+		var outputs []string
+		var logs []*LogEntry
+		for _, item := range outputStatus {
+			if item.output.isJson {
+				l := ""
+				level, foundLevel := item.output.jsonObj["level"].(string)
+				if foundLevel {
+					l = level
+					delete(item.output.jsonObj, "level")
+				}
+				delete(item.output.jsonObj, "time")
+				title, foundTitle := item.output.jsonObj[gunit.Title].(string)
+				if foundTitle {
+					testTitles[key] = title
+				} else {
+					redactor.redactObject(item.output.jsonObj)
+					logs = append(logs, newLogEntry(item.output.logTime, l, item.output.jsonObj))
+					if _, ok := item.output.jsonObj[gunit.RequestApi]; ok {
+						bs, _ := json.MarshalIndent(item.output.jsonObj, "", "    ")
+						outputs = append(outputs, fmt.Sprintf("---\n%s|%s ~ \n%s\n---\n", item.output.logTime, l, string(bs)))
+					} else {
+						bs, _ := json.Marshal(item.output.jsonObj)
+						outputs = append(outputs, fmt.Sprintf("%s|%s ~ %s\n", item.output.logTime, l, string(bs)))
+					}
+				}
+			} else {
+				outputs = append(outputs, item.output.line)
+			}
+		}
+		return outputs, logs
+	}
+
+	for _, status := range allTests {
+		for _, attempt := range status.Attempts {
+			for i, output := range attempt.Output {
+				attempt.Output[i] = redactor.redactText(output)
+			}
+		}
+	}
+	for key, _ := range allTests {
+		if _, ok := testsOutputs[key]; ok {
+			if testsInPackages[allTests[key].Package] == nil {
+				testsInPackages[allTests[key].Package] = make(map[string]*TestStatus)
+			}
+			sort.SliceStable(testsOutputs[key], func(i, j int) bool {
+				return testOutputStatus{testsOutputs[key][i].output, testsOutputs[key][i].time}.Less(testOutputStatus{testsOutputs[key][j].output, testsOutputs[key][j].time})
+			})
+			outputs, logs := genOutputs(key, testsOutputs[key])
+			if title, ok := testTitles[key]; ok {
+				newKey := fmt.Sprintf("%s(%s)", key, title)
+				newAllTests[newKey] = allTests[key]
+
+				newAllTests[newKey].Output = outputs
+				newAllTests[newKey].LogEntries = logs
+				newAllTests[newKey].Title = title
+				newAllTests[newKey].TestName = fmt.Sprintf("%s(%s)", newAllTests[newKey].TestName, title)
+				testsInPackages[allTests[key].Package][newKey] = newAllTests[newKey]
+			} else {
+				newAllTests[key] = allTests[key]
+				newAllTests[key].Output = outputs
+				newAllTests[key].LogEntries = logs
+				testsInPackages[allTests[key].Package][key] = newAllTests[key]
+			}
+		}
+	}
+	return newAllTests, testsInPackages
+}
+
+// newLogEntry creates a LogEntry from a gunit JSON log object whose level and time have already been extracted.
+// The "message" attribute is kept apart from the remaining fields.
+func newLogEntry(logTime time.Time, level string, jsonObj map[string]interface{}) *LogEntry {
+	entry := &LogEntry{
+		Time:   logTime,
+		Level:  level,
+		Fields: map[string]interface{}{},
+	}
+	for k, v := range jsonObj {
+		if message, ok := v.(string); ok && k == "message" {
+			entry.Message = message
+			continue
+		}
+		entry.Fields[k] = v
+	}
+	return entry
+}
@@ -0,0 +1,62 @@
+package report
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFormatTestsKeepsGunitLogEntries(t *testing.T) {
+	assertions := assert.New(t)
+	allTests := map[string]*TestStatus{}
+	allTests["foo.TestFunc1"] = &TestStatus{
+		TestName: "TestFunc1",
+		Package:  "foo",
+		Output: []string{
+			"=== RUN   TestFunc1\n",
+			`{"Test":"TestFunc1","Package":"foo","time":"2021-11-10T21:28:34+08:00","Title":"sample title"}` + "\n",
+			`{"level":"info","Test":"TestFunc1","Package":"foo","time":"2021-11-10T21:28:35+08:00","message":"hello","roomId":"42"}` + "\n",
+			"--- PASS: TestFunc1 (0.00s)\n",
+		},
+		Passed: true,
+	}
+	newAllTests, testsInPackages := FormatTests(allTests, nil)
+	assertions.Len(newAllTests, 1)
+	assertions.Contains(newAllTests, "foo.TestFunc1(sample title)")
+	assertions.Contains(testsInPackages["foo"], "foo.TestFunc1(sample title)")
+
+	status := newAllTests["foo.TestFunc1(sample title)"]
+	assertions.Equal("TestFunc1(sample title)", status.TestName)
+	assertions.Equal("sample title", status.Title)
+	assertions.Equal("TestFunc1", status.BaseTestName())
+	assertions.Len(status.LogEntries, 1)
+	assertions.Equal("info", status.LogEntries[0].Level)
+	assertions.Equal("hello", status.LogEntries[0].Message)
+	assertions.Equal(map[string]interface{}{"roomId": "42"}, status.LogEntries[0].Fields)
+}
+
+func TestFormatTestsSortsOutputByEventTime(t *testing.T) {
+	assertions := assert.New(t)
+	data := `{"Time":"2021-11-10T21:28:34.100+08:00","Action":"run","Package":"foo","Test":"TestFunc1"}
+{"Time":"2021-11-10T21:28:34.200+08:00","Action":"output","Package":"foo","Test":"TestFunc1","Output":"=== RUN   TestFunc1\n"}
+{"Time":"2021-11-10T21:28:34.300+08:00","Action":"output","Package":"foo","Test":"TestFunc1","Output":"    main_test.go:10: "}
+{"Time":"2021-11-10T21:28:34.400+08:00","Action":"output","Package":"foo","Test":"TestFunc1","Output":"before\n"}
+{"Time":"2021-11-10T21:28:34.500+08:00","Action":"output","Package":"foo","Test":"TestFunc1","Output":"{\"level\":\"info\",\"Test\":\"TestFunc1\",\"Package\":\"foo\",\"time\":\"2021-11-10T21:28:34+08:00\",\"message\":\"hello\"}\n"}
+{"Time":"2021-11-10T21:28:34.600+08:00","Action":"output","Package":"foo","Test":"TestFunc1","Output":"    main_test.go:12: after\n"}
+{"Time":"2021-11-10T21:28:34.700+08:00","Action":"output","Package":"foo","Test":"TestFunc1","Output":"--- PASS: TestFunc1 (0.60s)\n"}
+{"Time":"2021-11-10T21:28:34.700+08:00","Action":"pass","Package":"foo","Test":"TestFunc1","Elapsed":0.6}
+`
+	results, err := ParseEvents(strings.NewReader(data), ParseOptions{})
+	allTests := results.Tests
+	assertions.Nil(err)
+	newAllTests, _ := FormatTests(allTests, nil)
+	status := newAllTests["foo.TestFunc1"]
+	assertions.Len(status.Output, 5)
+	assertions.Equal("=== RUN   TestFunc1\n", status.Output[0])
+	assertions.Equal("    main_test.go:10: before\n", status.Output[1])
+	assertions.True(strings.HasSuffix(status.Output[2], `|info ~ {"message":"hello"}`+"\n"))
+	assertions.Equal("    main_test.go:12: after\n", status.Output[3])
+	assertions.Equal("--- PASS: TestFunc1 (0.60s)\n", status.Output[4])
+	assertions.Equal("2021-11-10T21:28:34+08:00", status.LogEntries[0].Time.Format(time.RFC3339))
+}
@@ -0,0 +1,303 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// historySchemaVersion is the version of the run files written to the history directory.
+const historySchemaVersion = 1
+
+const (
+	historyFilePrefix = "run-"
+	historyFileSuffix = ".json"
+	// historyFileTimeFormat makes the file names of the history directory sort chronologically.
+	historyFileTimeFormat = "20060102T150405.000000000Z"
+	// historyFileSequenceFormat keeps the file names unique, and in order, when runs share the same time.
+	historyFileSequenceFormat = "-%06d"
+)
+
+// flakyHistoryFlips is the number of changes between passed and failed over the runs of the trend section from which
+// a test is flaky; a single change is a regression or a fix.
+const flakyHistoryFlips = 2
+
+type (
+	// historyRun is the content of a run file of the history directory (--history-dir).
+	historyRun struct {
+		SchemaVersion int               `json:"schemaVersion"`
+		Time          time.Time         `json:"time"`
+		Commit        string            `json:"commit,omitempty"`
+		RunInfo       map[string]string `json:"runInfo,omitempty"`
+		Tests         []*historyTest    `json:"tests"`
+	}
+
+	historyTest struct {
+		Package string `json:"package"`
+		// Name is the test name as reported by go test, without the gunit title.
+		Name           string  `json:"name"`
+		Title          string  `json:"title,omitempty"`
+		Status         string  `json:"status"`
+		Omitted        bool    `json:"omitted,omitempty"`
+		ElapsedSeconds float64 `json:"elapsedSeconds"`
+	}
+
+	// HistoryTrend is the trend section of the report, computed from the last runs of the history directory.
+	HistoryTrend struct {
+		// Runs holds the last runs, oldest first; the last one is the current run.
+		Runs []*TrendRun
+		// FailingTests holds the tests failing in the current run, along with the run they started to fail in.
+		FailingTests []*FailingTest
+	}
+
+	// TrendRun is a run of the trend section, along with its counters; omitted parent tests are not counted.
+	TrendRun struct {
+		// Time is the time of the run as shown in the report, and Commit its short commit hash, if known.
+		Time     string
+		Commit   string
+		Passed   int
+		Failed   int
+		Skipped  int
+		PassRate float64
+	}
+
+	// FailingTest is a test failing in the current run, along with the time and commit of the first run of its
+	// current failure streak.
+	FailingTest struct {
+		Package           string
+		TestName          string
+		FirstFailedAt     string
+		FirstFailedCommit string
+	}
+
+	// TestHistory is the history of a single test over the runs of the trend section, oldest first.
+	TestHistory struct {
+		// Durations holds the duration of the test in each run, or nil if it did not run.
+		Durations []*float64
+		// Statuses holds "passed", "failed" or "skipped" for each run, or "" if the test did not run.
+		Statuses []string
+		// FirstFailedAt is the time of the first run of the current failure streak of a failed test.
+		FirstFailedAt     string
+		FirstFailedCommit string
+	}
+)
+
+// UpdateHistory stores the results of the current run in the history directory, then computes the trend section
+// and the history of each test from the last historySize runs. The whole history is used to find when a failing
+// test started to fail. Tests whose result flipped repeatedly are marked as flaky. The commit of the run is read from
+// its run info (RunInfoCommit).
+//
+// runTime is the wall-clock time of the run, which names its file and orders it among the runs of the history; the
+// time shown for the run in the report is the report time of tmplData if set.
+func UpdateHistory(historyDir string, historySize int, tmplData *Data, testsInPackages map[string]map[string]*TestStatus, runTime time.Time) error {
+	runs, err := readHistory(historyDir)
+	if err != nil {
+		return err
+	}
+	runInfo := runInfoMap(tmplData.RunInfo)
+	shownTime := runTime
+	if !tmplData.ReportTime.IsZero() {
+		shownTime = tmplData.ReportTime
+	}
+	currentRun := newHistoryRun(testsInPackages, shownTime, runInfo[RunInfoCommit])
+	currentRun.RunInfo = runInfo
+	if err := writeHistoryRun(historyDir, currentRun, runTime, len(runs)+1); err != nil {
+		return err
+	}
+	runs = append(runs, currentRun)
+	trendRuns := runs
+	if len(trendRuns) > historySize {
+		trendRuns = trendRuns[len(trendRuns)-historySize:]
+	}
+
+	trend := &HistoryTrend{}
+	for _, run := range trendRuns {
+		trend.Runs = append(trend.Runs, newTrendRun(run))
+	}
+	testsByRun := make([]map[string]*historyTest, len(runs))
+	for i, run := range runs {
+		testsByRun[i] = map[string]*historyTest{}
+		for _, test := range run.Tests {
+			testsByRun[i][test.key()] = test
+		}
+	}
+	for _, tests := range testsInPackages {
+		for _, status := range tests {
+			key := status.Package + "." + status.TestName
+			history := &TestHistory{}
+			for i := len(runs) - len(trendRuns); i < len(runs); i++ {
+				test := testsByRun[i][key]
+				if test == nil {
+					history.Durations = append(history.Durations, nil)
+					history.Statuses = append(history.Statuses, "")
+					continue
+				}
+				elapsedSeconds := test.ElapsedSeconds
+				history.Durations = append(history.Durations, &elapsedSeconds)
+				history.Statuses = append(history.Statuses, test.Status)
+			}
+			if !status.Passed && !status.Skipped {
+				firstFailedRun := len(runs) - 1
+				for firstFailedRun > 0 {
+					test := testsByRun[firstFailedRun-1][key]
+					if test == nil || test.Status != jsonReportStatusFailed {
+						break
+					}
+					firstFailedRun--
+				}
+				history.FirstFailedAt = formatExecutionDate(runs[firstFailedRun].Time.Local())
+				history.FirstFailedCommit = shortCommit(runs[firstFailedRun].Commit)
+				if !status.Omitted {
+					trend.FailingTests = append(trend.FailingTests, &FailingTest{
+						Package:           status.Package,
+						TestName:          status.TestName,
+						FirstFailedAt:     history.FirstFailedAt,
+						FirstFailedCommit: history.FirstFailedCommit,
+					})
+				}
+			}
+			status.History = history
+			if history.flips() >= flakyHistoryFlips {
+				status.Flaky = true
+			}
+		}
+	}
+	sort.Slice(trend.FailingTests, func(i, j int) bool {
+		if trend.FailingTests[i].Package != trend.FailingTests[j].Package {
+			return trend.FailingTests[i].Package < trend.FailingTests[j].Package
+		}
+		return trend.FailingTests[i].TestName < trend.FailingTests[j].TestName
+	})
+	tmplData.Trend = trend
+	return nil
+}
+
+// readHistory reads the runs of the history directory, oldest first. The directory is created if needed.
+func readHistory(historyDir string) ([]*historyRun, error) {
+	if err := os.MkdirAll(historyDir, 0755); err != nil {
+		return nil, err
+	}
+	files, err := ioutil.ReadDir(historyDir)
+	if err != nil {
+		return nil, err
+	}
+	var runs []*historyRun
+	for _, file := range files {
+		if file.IsDir() || !strings.HasPrefix(file.Name(), historyFilePrefix) || !strings.HasSuffix(file.Name(), historyFileSuffix) {
+			continue
+		}
+		fileName := filepath.Join(historyDir, file.Name())
+		content, err := ioutil.ReadFile(fileName)
+		if err != nil {
+			return nil, err
+		}
+		run := &historyRun{}
+		if err := json.Unmarshal(content, run); err != nil {
+			return nil, fmt.Errorf("%s: %s", fileName, err)
+		}
+		if run.SchemaVersion != historySchemaVersion {
+			return nil, fmt.Errorf("%s: unsupported schema version %d", fileName, run.SchemaVersion)
+		}
+		runs = append(runs, run)
+	}
+	// ReadDir sorts by file name, which sorts by time, then by sequence number
+	return runs, nil
+}
+
+// writeHistoryRun writes the run file named after the wall-clock time and the sequence number of the run.
+func writeHistoryRun(historyDir string, run *historyRun, runTime time.Time, sequence int) error {
+	fileName := filepath.Join(historyDir, historyFilePrefix+runTime.UTC().Format(historyFileTimeFormat)+
+		fmt.Sprintf(historyFileSequenceFormat, sequence)+historyFileSuffix)
+	content, err := json.Marshal(run)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(fileName, content, 0644)
+}
+
+func newHistoryRun(testsInPackages map[string]map[string]*TestStatus, runTime time.Time, commit string) *historyRun {
+	run := &historyRun{
+		SchemaVersion: historySchemaVersion,
+		Time:          runTime,
+		Commit:        commit,
+		Tests:         []*historyTest{},
+	}
+	for _, tests := range testsInPackages {
+		for _, status := range tests {
+			test := &historyTest{
+				Package:        status.Package,
+				Name:           status.BaseTestName(),
+				Title:          status.Title,
+				Status:         jsonReportStatus(status.Passed, status.Skipped),
+				Omitted:        status.Omitted,
+				ElapsedSeconds: status.ElapsedTime,
+			}
+			run.Tests = append(run.Tests, test)
+		}
+	}
+	sort.Slice(run.Tests, func(i, j int) bool {
+		return run.Tests[i].key() < run.Tests[j].key()
+	})
+	return run
+}
+
+func newTrendRun(run *historyRun) *TrendRun {
+	trend := &TrendRun{
+		Time:   formatExecutionDate(run.Time.Local()),
+		Commit: shortCommit(run.Commit),
+	}
+	for _, test := range run.Tests {
+		if test.Omitted {
+			continue
+		}
+		switch test.Status {
+		case jsonReportStatusPassed:
+			trend.Passed++
+		case jsonReportStatusSkipped:
+			trend.Skipped++
+		default:
+			trend.Failed++
+		}
+	}
+	if trend.Passed+trend.Failed > 0 {
+		trend.PassRate = 100 * float64(trend.Passed) / float64(trend.Passed+trend.Failed)
+	}
+	return trend
+}
+
+// flips counts the changes between passed and failed over the runs of a test, ignoring the runs in which it was
+// skipped or did not run.
+func (history *TestHistory) flips() int {
+	flips := 0
+	previous := ""
+	for _, status := range history.Statuses {
+		if status != jsonReportStatusPassed && status != jsonReportStatusFailed {
+			continue
+		}
+		if previous != "" && status != previous {
+			flips++
+		}
+		previous = status
+	}
+	return flips
+}
+
+// key returns the key of the test in the maps of the report, i.e. "<package>.<test>(<title>)".
+func (test *historyTest) key() string {
+	if test.Title != "" {
+		return fmt.Sprintf("%s.%s(%s)", test.Package, test.Name, test.Title)
+	}
+	return test.Package + "." + test.Name
+}
+
+func shortCommit(commit string) string {
+	if len(commit) > 7 {
+		return commit[:7]
+	}
+	return commit
+}
@@ -0,0 +1,103 @@
+package report
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestUpdateHistory(t *testing.T) {
+	assertions := assert.New(t)
+	dir, err := ioutil.TempDir("", "go-test-report")
+	assertions.Nil(err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	historyDir := filepath.Join(dir, "history")
+	newTests := func(func1Passed bool, func2Elapsed float64) map[string]map[string]*TestStatus {
+		return map[string]map[string]*TestStatus{
+			"foo": {
+				"foo.TestFunc1": {
+					TestName:    "TestFunc1",
+					Package:     "foo",
+					ElapsedTime: 1,
+					Passed:      func1Passed,
+				},
+				"foo.TestFunc2(sample title)": {
+					TestName:    "TestFunc2(sample title)",
+					Title:       "sample title",
+					Package:     "foo",
+					ElapsedTime: func2Elapsed,
+					Passed:      true,
+				},
+			},
+		}
+	}
+	runTime := time.Date(2020, time.July, 10, 1, 24, 44, 0, time.Local)
+	assertions.Nil(UpdateHistory(historyDir, 3, &Data{}, newTests(true, 1), runTime))
+	assertions.Nil(UpdateHistory(historyDir, 3, &Data{}, newTests(false, 2), runTime.Add(time.Hour)))
+	assertions.Nil(UpdateHistory(historyDir, 3, &Data{}, newTests(false, 3), runTime.Add(2*time.Hour)))
+	tmplData := &Data{}
+	testsInPackages := newTests(false, 4)
+	assertions.Nil(UpdateHistory(historyDir, 3, tmplData, testsInPackages, runTime.Add(3*time.Hour)))
+
+	files, err := ioutil.ReadDir(historyDir)
+	assertions.Nil(err)
+	assertions.Len(files, 4)
+	assertions.Equal("run-"+runTime.UTC().Format("20060102T150405")+".000000000Z-000001.json", files[0].Name())
+
+	assertions.Len(tmplData.Trend.Runs, 3)
+	assertions.Equal(50.0, tmplData.Trend.Runs[2].PassRate)
+	assertions.Equal(1, tmplData.Trend.Runs[2].Failed)
+	assertions.Len(tmplData.Trend.FailingTests, 1)
+	assertions.Equal("TestFunc1", tmplData.Trend.FailingTests[0].TestName)
+	assertions.Equal("July 10, 2020 02:24:44", tmplData.Trend.FailingTests[0].FirstFailedAt)
+
+	history := testsInPackages["foo"]["foo.TestFunc2(sample title)"].History
+	assertions.Len(history.Durations, 3)
+	assertions.Equal(2.0, *history.Durations[0])
+	assertions.Equal(4.0, *history.Durations[2])
+	assertions.Equal([]string{"passed", "passed", "passed"}, history.Statuses)
+	assertions.Equal("", history.FirstFailedAt)
+	assertions.Equal([]string{"failed", "failed", "failed"}, testsInPackages["foo"]["foo.TestFunc1"].History.Statuses)
+}
+
+func TestUpdateHistoryWithFixedTime(t *testing.T) {
+	assertions := assert.New(t)
+	dir, err := ioutil.TempDir("", "go-test-report")
+	assertions.Nil(err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	newTests := func() map[string]map[string]*TestStatus {
+		return map[string]map[string]*TestStatus{
+			"foo": {
+				"foo.TestFunc1": {TestName: "TestFunc1", Package: "foo", ElapsedTime: 1, Passed: true},
+			},
+		}
+	}
+	runTime := time.Date(2020, time.July, 10, 1, 24, 44, 0, time.UTC)
+	reportTime := time.Date(2021, time.January, 2, 3, 4, 5, 0, time.Local)
+	assertions.Nil(UpdateHistory(dir, 3, &Data{ReportTime: reportTime}, newTests(), runTime))
+	tmplData := &Data{ReportTime: reportTime}
+	assertions.Nil(UpdateHistory(dir, 3, tmplData, newTests(), runTime))
+
+	files, err := ioutil.ReadDir(dir)
+	assertions.Nil(err)
+	assertions.Len(files, 2)
+	assertions.Equal("run-20200710T012444.000000000Z-000001.json", files[0].Name())
+	assertions.Equal("run-20200710T012444.000000000Z-000002.json", files[1].Name())
+	assertions.Len(tmplData.Trend.Runs, 2)
+	assertions.Equal("January 2, 2021 03:04:05", tmplData.Trend.Runs[1].Time)
+}
+
+func TestTestHistoryFlips(t *testing.T) {
+	assertions := assert.New(t)
+	history := &TestHistory{Statuses: []string{"passed", "", "passed", "failed", "skipped", "failed"}}
+	assertions.Equal(1, history.flips())
+	history.Statuses = append(history.Statuses, "passed")
+	assertions.Equal(2, history.flips())
+}
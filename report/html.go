@@ -0,0 +1,28 @@
+package report
+
+import (
+	"encoding/hex"
+	"html/template"
+	"io"
+)
+
+// RenderHTML writes the self-contained HTML report of data, as computed by Build.
+func RenderHTML(tmplData *Data, writer io.Writer) error {
+	// read the html template from the generated embedded asset go file
+	tpl := template.New("test_report.html.template")
+	testReportHTMLTemplateStr, err := hex.DecodeString(testReportHTMLTemplate)
+	if err != nil {
+		return err
+	}
+	tpl, err = tpl.Parse(string(testReportHTMLTemplateStr))
+	if err != nil {
+		return err
+	}
+	// read Javascript code from the generated embedded asset go file
+	testReportJsCodeStr, err := hex.DecodeString(testReportJsCode)
+	if err != nil {
+		return err
+	}
+	tmplData.JsCode = template.JS(testReportJsCodeStr)
+	return tpl.Execute(writer, tmplData)
+}
@@ -0,0 +1,293 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// jsonReportSchemaVersion is the version of the JSON report schema. It is incremented whenever a field is removed
+// or its meaning changes; new fields may be added without changing the version. The schema is documented for other
+// tools in json_report.schema.json, which must be updated along with the types below.
+const jsonReportSchemaVersion = 1
+
+const (
+	jsonReportStatusPassed  = "passed"
+	jsonReportStatusFailed  = "failed"
+	jsonReportStatusSkipped = "skipped"
+)
+
+type (
+	// JSONReport is the root object of the JSON report written with --json-out. It holds the results shown in the
+	// HTML report; the trend, diff, timeline, assertion diff and API request sections are not exported.
+	JSONReport struct {
+		// SchemaVersion is the version of this schema, see jsonReportSchemaVersion.
+		SchemaVersion int `json:"schemaVersion"`
+		// Title is the report title (--title).
+		Title string `json:"title"`
+		// ExecutionDate is the date shown in the header of the HTML report.
+		ExecutionDate string `json:"executionDate"`
+		// DurationSeconds is the total duration of the test run.
+		DurationSeconds float64 `json:"durationSeconds"`
+		// RunInfo holds the run info shown in the header of the HTML report by name, e.g. "git.commit".
+		RunInfo map[string]string `json:"runInfo,omitempty"`
+		// Totals holds the counters shown in the header of the HTML report.
+		Totals JSONReportTotals `json:"totals"`
+		// FailedTests holds the "<package>.<test>" keys of the failed tests.
+		FailedTests []string `json:"failedTests"`
+		// BuildFailedPackages holds the packages that could not be built.
+		BuildFailedPackages []string `json:"buildFailedPackages"`
+		// Packages holds one entry per package, in the order of the HTML report.
+		Packages []*JSONReportPackage `json:"packages"`
+	}
+
+	// JSONReportTotals holds the counters of the report. Omitted parent tests are not counted.
+	JSONReportTotals struct {
+		// Tests is the number of passed, failed and skipped tests.
+		Tests   int `json:"tests"`
+		Passed  int `json:"passed"`
+		Failed  int `json:"failed"`
+		Skipped int `json:"skipped"`
+		// Interrupted is the number of failed tests that were interrupted.
+		Interrupted int `json:"interrupted"`
+		// Flaky is the number of flaky tests, whatever their final status.
+		Flaky int `json:"flaky"`
+	}
+
+	// JSONReportPackage holds the results of a package.
+	JSONReportPackage struct {
+		// Name is the import path of the package.
+		Name string `json:"name"`
+		// Failed is true when at least one test of the package failed.
+		Failed bool `json:"failed"`
+		// ElapsedSeconds is the sum of the durations of the top level tests of the package.
+		ElapsedSeconds float64 `json:"elapsedSeconds"`
+		// Tests holds the tests of the package, including subtests.
+		Tests []*JSONReportTest `json:"tests"`
+	}
+
+	// JSONReportTest holds the result of a test or subtest.
+	JSONReportTest struct {
+		// Name is the test name as reported by go test, e.g. "TestFoo/Case1".
+		Name string `json:"name"`
+		// Title is the gunit title of the test, if any.
+		Title string `json:"title,omitempty"`
+		// Package is the import path of the package of the test.
+		Package string `json:"package"`
+		// Status is one of "passed", "failed" or "skipped".
+		Status string `json:"status"`
+		// Omitted is true for parent tests whose result is represented by their subtests; such tests are not
+		// included in the totals.
+		Omitted bool `json:"omitted"`
+		// PackageLevel is true for the synthetic "[package]" test holding the output of a package that failed
+		// outside of its tests, e.g. because it could not be built.
+		PackageLevel bool `json:"packageLevel,omitempty"`
+		// Interrupted is true for failed tests that started but never finished, e.g. because of a timeout.
+		Interrupted bool `json:"interrupted,omitempty"`
+		// InterruptReason is "timeout" or "panic" when the cause of an interruption is known.
+		InterruptReason string `json:"interruptReason,omitempty"`
+		// ElapsedSeconds is the duration of the test as reported by go test.
+		ElapsedSeconds float64 `json:"elapsedSeconds"`
+		// Shard is the name of the input the test was read from when merging several test runs.
+		Shard string `json:"shard,omitempty"`
+		// Flaky is true when the attempts of the test disagree, or when its result flipped in the history.
+		Flaky bool `json:"flaky,omitempty"`
+		// Attempts holds each run of a test that ran several times, e.g. with -count=N; its status is the one of
+		// the last attempt.
+		Attempts []*JSONReportAttempt `json:"attempts,omitempty"`
+		// File is the location of the test function, if it could be found.
+		File *JSONReportFile `json:"file,omitempty"`
+		// Output holds the console output of the test.
+		Output []string `json:"output"`
+		// Logs holds the structured gunit log entries of the test.
+		Logs []*JSONReportLogEntry `json:"logs,omitempty"`
+	}
+
+	// JSONReportAttempt holds a single run of a test that ran several times, oldest first.
+	JSONReportAttempt struct {
+		// Status is one of "passed", "failed" or "skipped".
+		Status         string   `json:"status"`
+		ElapsedSeconds float64  `json:"elapsedSeconds"`
+		Output         []string `json:"output"`
+	}
+
+	// JSONReportFile is the location of a test function in its source file.
+	JSONReportFile struct {
+		// Name is the base name of the test file.
+		Name string `json:"name"`
+		Line int    `json:"line"`
+		Col  int    `json:"col"`
+	}
+
+	// JSONReportLogEntry is a structured gunit log record of a test.
+	JSONReportLogEntry struct {
+		Time time.Time `json:"time"`
+		// Level is the log level of the record, if any.
+		Level   string `json:"level,omitempty"`
+		Message string `json:"message,omitempty"`
+		// Fields holds the other fields of the record by name.
+		Fields map[string]interface{} `json:"fields,omitempty"`
+	}
+)
+
+// newJSONReport converts the data model of an already generated report into the JSON report schema.
+func newJSONReport(tmplData *Data) *JSONReport {
+	report := &JSONReport{
+		SchemaVersion:   jsonReportSchemaVersion,
+		Title:           tmplData.ReportTitle,
+		ExecutionDate:   tmplData.TestExecutionDate,
+		DurationSeconds: tmplData.TestDuration.Seconds(),
+		RunInfo:         runInfoMap(tmplData.RunInfo),
+		Totals: JSONReportTotals{
+			Tests:       tmplData.NumOfTests,
+			Passed:      tmplData.NumOfTestPassed,
+			Failed:      tmplData.NumOfTestFailed,
+			Skipped:     tmplData.NumOfTestSkipped,
+			Interrupted: tmplData.NumOfTestInterrupted,
+			Flaky:       tmplData.NumOfTestFlaky,
+		},
+		FailedTests:         tmplData.FailedTestNames,
+		BuildFailedPackages: tmplData.BuildFailedPackages,
+		Packages:            []*JSONReportPackage{},
+	}
+	if report.FailedTests == nil {
+		report.FailedTests = []string{}
+	}
+	if report.BuildFailedPackages == nil {
+		report.BuildFailedPackages = []string{}
+	}
+	for _, group := range tmplData.TestResults {
+		pkg := &JSONReportPackage{
+			Name:           group.PackageName,
+			Failed:         group.FailureIndicator != "",
+			ElapsedSeconds: group.ElapsedTime,
+			Tests:          []*JSONReportTest{},
+		}
+		for _, status := range group.TestResults {
+			pkg.Tests = append(pkg.Tests, newJSONReportTest(status))
+		}
+		report.Packages = append(report.Packages, pkg)
+	}
+	return report
+}
+
+func newJSONReportTest(status *TestStatus) *JSONReportTest {
+	test := &JSONReportTest{
+		Name:            status.BaseTestName(),
+		Title:           status.Title,
+		Package:         status.Package,
+		Status:          jsonReportStatusFailed,
+		Omitted:         status.Omitted,
+		PackageLevel:    status.PackageLevel,
+		Interrupted:     status.Interrupted,
+		InterruptReason: status.InterruptReason,
+		ElapsedSeconds:  status.ElapsedTime,
+		Shard:           status.Shard,
+		Flaky:           status.Flaky,
+		Output:          status.Output,
+	}
+	test.Status = jsonReportStatus(status.Passed, status.Skipped)
+	if test.Output == nil {
+		test.Output = []string{}
+	}
+	if status.TestFileName != "" {
+		test.File = &JSONReportFile{
+			Name: status.TestFileName,
+			Line: status.TestFunctionDetail.Line,
+			Col:  status.TestFunctionDetail.Col,
+		}
+	}
+	for _, attempt := range status.Attempts {
+		test.Attempts = append(test.Attempts, &JSONReportAttempt{
+			Status:         jsonReportStatus(attempt.Passed, attempt.Skipped),
+			ElapsedSeconds: attempt.ElapsedTime,
+			Output:         attempt.Output,
+		})
+	}
+	for _, entry := range status.LogEntries {
+		test.Logs = append(test.Logs, &JSONReportLogEntry{
+			Time:    entry.Time,
+			Level:   entry.Level,
+			Message: entry.Message,
+			Fields:  entry.Fields,
+		})
+	}
+	return test
+}
+
+// jsonReportStatus returns the status of a test or attempt in the JSON report.
+func jsonReportStatus(passed bool, skipped bool) string {
+	if passed {
+		return jsonReportStatusPassed
+	} else if skipped {
+		return jsonReportStatusSkipped
+	}
+	return jsonReportStatusFailed
+}
+
+// TestStatus converts a test of a JSON report back into the data model of the report.
+func (test *JSONReportTest) TestStatus() *TestStatus {
+	status := &TestStatus{
+		TestName:        test.Name,
+		Package:         test.Package,
+		ElapsedTime:     test.ElapsedSeconds,
+		Output:          test.Output,
+		Passed:          test.Status == jsonReportStatusPassed,
+		Skipped:         test.Status == jsonReportStatusSkipped,
+		Omitted:         test.Omitted,
+		Title:           test.Title,
+		PackageLevel:    test.PackageLevel,
+		Interrupted:     test.Interrupted,
+		InterruptReason: test.InterruptReason,
+		Shard:           test.Shard,
+		Flaky:           test.Flaky,
+	}
+	if test.Title != "" {
+		status.TestName = fmt.Sprintf("%s(%s)", test.Name, test.Title)
+	}
+	if test.File != nil {
+		status.TestFileName = test.File.Name
+		status.TestFunctionDetail = TestFunctionFilePos{
+			Line: test.File.Line,
+			Col:  test.File.Col,
+		}
+	}
+	for _, attempt := range test.Attempts {
+		status.Attempts = append(status.Attempts, &TestAttempt{
+			Passed:      attempt.Status == jsonReportStatusPassed,
+			Skipped:     attempt.Status == jsonReportStatusSkipped,
+			ElapsedTime: attempt.ElapsedSeconds,
+			Output:      attempt.Output,
+		})
+	}
+	for _, entry := range test.Logs {
+		status.LogEntries = append(status.LogEntries, &LogEntry{
+			Time:    entry.Time,
+			Level:   entry.Level,
+			Message: entry.Message,
+			Fields:  entry.Fields,
+		})
+	}
+	return status
+}
+
+// ReadJSONReport parses content as a JSON report. It returns nil and no error if content is not a JSON report, e.g.
+// because it is "go test -json" output, and an error if the report was written with a newer schema version.
+func ReadJSONReport(content []byte) (*JSONReport, error) {
+	report := &JSONReport{}
+	if err := json.Unmarshal(content, report); err != nil || report.SchemaVersion == 0 {
+		return nil, nil
+	}
+	if report.SchemaVersion > jsonReportSchemaVersion {
+		return nil, fmt.Errorf("unsupported schema version %d", report.SchemaVersion)
+	}
+	return report, nil
+}
+
+// RenderJSON writes the JSON report of an already generated report.
+func RenderJSON(tmplData *Data, writer io.Writer) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(newJSONReport(tmplData))
+}
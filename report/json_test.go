@@ -0,0 +1,157 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRenderJSON(t *testing.T) {
+	assertions := assert.New(t)
+	logTime := time.Date(2021, 11, 10, 21, 28, 34, 0, time.UTC)
+	tmplData := &Data{
+		ReportTitle:       "test-title",
+		TestExecutionDate: "November 10, 2021 21:28:40",
+		TestDuration:      1500 * time.Millisecond,
+		NumOfTests:        2,
+		NumOfTestPassed:   1,
+		NumOfTestFailed:   1,
+		FailedTestNames:   []string{"go-test-report.TestFunc2"},
+		RunInfo:           []*RunInfoEntry{{Name: "git.commit", Value: "0123456789abcdef"}, {Name: "env", Value: "staging"}},
+		TestResults: []*TestGroup{
+			{
+				FailureIndicator: "failed",
+				PackageName:      "go-test-report",
+				TestResults: []*TestStatus{
+					{
+						TestName:     "TestFunc1(sample title)",
+						Title:        "sample title",
+						Package:      "go-test-report",
+						ElapsedTime:  1.25,
+						Output:       []string{"=== RUN   TestFunc1\n"},
+						Passed:       true,
+						TestFileName: "sample_file_1.go",
+						TestFunctionDetail: TestFunctionFilePos{
+							Line: 101,
+							Col:  1,
+						},
+						LogEntries: []*LogEntry{
+							{
+								Time:    logTime,
+								Level:   "info",
+								Message: "try to delete roomId:1636550819514658",
+								Fields:  map[string]interface{}{"roomId": "1636550819514658"},
+							},
+						},
+					},
+					{
+						TestName: "TestFunc2",
+						Package:  "go-test-report",
+					},
+				},
+			},
+		},
+	}
+	buffer := bytes.NewBufferString("")
+	err := RenderJSON(tmplData, buffer)
+	assertions.Nil(err)
+
+	report := &JSONReport{}
+	assertions.Nil(json.Unmarshal(buffer.Bytes(), report))
+	assertions.Equal(jsonReportSchemaVersion, report.SchemaVersion)
+	assertions.Equal("test-title", report.Title)
+	assertions.Equal(1.5, report.DurationSeconds)
+	assertions.Equal(map[string]string{"git.commit": "0123456789abcdef", "env": "staging"}, report.RunInfo)
+	assertions.Equal(JSONReportTotals{Tests: 2, Passed: 1, Failed: 1}, report.Totals)
+	assertions.Equal([]string{"go-test-report.TestFunc2"}, report.FailedTests)
+	assertions.Len(report.Packages, 1)
+	assertions.Equal("go-test-report", report.Packages[0].Name)
+	assertions.True(report.Packages[0].Failed)
+	assertions.Len(report.Packages[0].Tests, 2)
+
+	test := report.Packages[0].Tests[0]
+	assertions.Equal("TestFunc1", test.Name)
+	assertions.Equal("sample title", test.Title)
+	assertions.Equal(jsonReportStatusPassed, test.Status)
+	assertions.Equal(1.25, test.ElapsedSeconds)
+	assertions.Equal(&JSONReportFile{Name: "sample_file_1.go", Line: 101, Col: 1}, test.File)
+	assertions.Len(test.Logs, 1)
+	assertions.True(logTime.Equal(test.Logs[0].Time))
+	assertions.Equal("info", test.Logs[0].Level)
+	assertions.Equal("try to delete roomId:1636550819514658", test.Logs[0].Message)
+	assertions.Equal("1636550819514658", test.Logs[0].Fields["roomId"])
+
+	test = report.Packages[0].Tests[1]
+	assertions.Equal("TestFunc2", test.Name)
+	assertions.Equal(jsonReportStatusFailed, test.Status)
+	assertions.Nil(test.File)
+	assertions.Equal([]string{}, test.Output)
+}
+
+func TestReadJSONReport(t *testing.T) {
+	assertions := assert.New(t)
+	report, err := ReadJSONReport([]byte(`{"schemaVersion": 1, "title": "test-title"}`))
+	assertions.Nil(err)
+	assertions.Equal("test-title", report.Title)
+
+	report, err = ReadJSONReport([]byte(`{"Action":"run","Package":"foo","Test":"TestFunc1"}`))
+	assertions.Nil(err)
+	assertions.Nil(report)
+
+	report, err = ReadJSONReport([]byte(`{"schemaVersion": 2}`))
+	assertions.EqualError(err, "unsupported schema version 2")
+	assertions.Nil(report)
+}
+
+func TestJSONReportSchemaFile(t *testing.T) {
+	assertions := assert.New(t)
+	content, err := ioutil.ReadFile("json_report.schema.json")
+	assertions.Nil(err)
+	schema := struct {
+		Properties map[string]struct {
+			Const      *int                   `json:"const"`
+			Properties map[string]interface{} `json:"properties"`
+		} `json:"properties"`
+		Definitions map[string]struct {
+			Properties map[string]interface{} `json:"properties"`
+		} `json:"definitions"`
+	}{}
+	assertions.Nil(json.Unmarshal(content, &schema))
+	assertions.Equal(jsonReportSchemaVersion, *schema.Properties["schemaVersion"].Const)
+
+	// every field written by the report is documented
+	propertyNames := func(properties map[string]interface{}) []string {
+		var names []string
+		for name := range properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return names
+	}
+	var reportNames []string
+	for name := range schema.Properties {
+		reportNames = append(reportNames, name)
+	}
+	sort.Strings(reportNames)
+	assertions.Equal(jsonFieldNames(JSONReport{}), reportNames)
+	assertions.Equal(jsonFieldNames(JSONReportTotals{}), propertyNames(schema.Properties["totals"].Properties))
+	assertions.Equal(jsonFieldNames(JSONReportPackage{}), propertyNames(schema.Definitions["package"].Properties))
+	assertions.Equal(jsonFieldNames(JSONReportTest{}), propertyNames(schema.Definitions["test"].Properties))
+}
+
+// jsonFieldNames returns the sorted JSON names of the fields of a struct.
+func jsonFieldNames(value interface{}) []string {
+	var names []string
+	valueType := reflect.TypeOf(value)
+	for i := 0; i < valueType.NumField(); i++ {
+		names = append(names, strings.Split(valueType.Field(i).Tag.Get("json"), ",")[0])
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,135 @@
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+type (
+	junitTestSuites struct {
+		XMLName  xml.Name          `xml:"testsuites"`
+		Name     string            `xml:"name,attr,omitempty"`
+		Tests    int               `xml:"tests,attr"`
+		Failures int               `xml:"failures,attr"`
+		Skipped  int               `xml:"skipped,attr"`
+		Time     string            `xml:"time,attr"`
+		Suites   []*junitTestSuite `xml:"testsuite"`
+	}
+
+	junitTestSuite struct {
+		Name       string           `xml:"name,attr"`
+		Tests      int              `xml:"tests,attr"`
+		Failures   int              `xml:"failures,attr"`
+		Skipped    int              `xml:"skipped,attr"`
+		Time       string           `xml:"time,attr"`
+		Properties *junitProperties `xml:"properties,omitempty"`
+		TestCases  []*junitTestCase `xml:"testcase"`
+	}
+
+	junitProperties struct {
+		Properties []*junitProperty `xml:"property"`
+	}
+
+	junitProperty struct {
+		Name  string `xml:"name,attr"`
+		Value string `xml:"value,attr"`
+	}
+
+	junitTestCase struct {
+		Name      string        `xml:"name,attr"`
+		ClassName string        `xml:"classname,attr"`
+		Time      string        `xml:"time,attr"`
+		File      string        `xml:"file,attr,omitempty"`
+		Line      int           `xml:"line,attr,omitempty"`
+		Failure   *junitFailure `xml:"failure,omitempty"`
+		Skipped   *junitSkipped `xml:"skipped,omitempty"`
+		SystemOut string        `xml:"system-out,omitempty"`
+	}
+
+	junitFailure struct {
+		Message string `xml:"message,attr"`
+		Type    string `xml:"type,attr"`
+		Body    string `xml:",chardata"`
+	}
+
+	junitSkipped struct {
+		Message string `xml:"message,attr"`
+	}
+)
+
+// RenderJUnit converts the test groups of an already generated report into JUnit XML, using one
+// <testsuite> per package. Omitted tests (parents whose result is represented by their subtests) are left out
+// so that the totals match the ones shown in the HTML report. The run info is written as the properties of each
+// suite, the <testsuites> element having none.
+func RenderJUnit(tmplData *Data, writer io.Writer) error {
+	suites := &junitTestSuites{Name: tmplData.ReportTitle}
+	var properties *junitProperties
+	if len(tmplData.RunInfo) > 0 {
+		properties = &junitProperties{}
+		for _, entry := range tmplData.RunInfo {
+			properties.Properties = append(properties.Properties, &junitProperty{Name: entry.Name, Value: entry.Value})
+		}
+	}
+	var totalTime float64
+	for _, group := range tmplData.TestResults {
+		suite := &junitTestSuite{Name: group.PackageName, Properties: properties}
+		var suiteTime float64
+		for _, status := range group.TestResults {
+			if status.Omitted {
+				continue
+			}
+			testCase := &junitTestCase{
+				Name:      status.TestName,
+				ClassName: status.Package,
+				Time:      formatJUnitTime(status.ElapsedTime),
+				File:      status.TestFileName,
+				Line:      status.TestFunctionDetail.Line,
+			}
+			output := strings.Join(status.Output, "")
+			switch {
+			case status.Passed:
+				testCase.SystemOut = output
+			case status.Skipped:
+				testCase.Skipped = &junitSkipped{Message: "Skipped"}
+				testCase.SystemOut = output
+				suite.Skipped++
+			case status.Interrupted:
+				message := "Interrupted"
+				if status.InterruptReason != "" {
+					message = fmt.Sprintf("Interrupted (%s)", status.InterruptReason)
+				}
+				testCase.Failure = &junitFailure{Message: message, Type: "interrupted", Body: output}
+				suite.Failures++
+			default:
+				testCase.Failure = &junitFailure{Message: "Failed", Body: output}
+				suite.Failures++
+			}
+			suite.Tests++
+			suiteTime += status.ElapsedTime
+			suite.TestCases = append(suite.TestCases, testCase)
+		}
+		suite.Time = formatJUnitTime(suiteTime)
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Skipped += suite.Skipped
+		totalTime += suiteTime
+		suites.Suites = append(suites.Suites, suite)
+	}
+	suites.Time = formatJUnitTime(totalTime)
+	if _, err := io.WriteString(writer, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(writer)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(suites); err != nil {
+		return err
+	}
+	_, err := io.WriteString(writer, "\n")
+	return err
+}
+
+func formatJUnitTime(seconds float64) string {
+	return fmt.Sprintf("%.3f", seconds)
+}
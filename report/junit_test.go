@@ -0,0 +1,94 @@
+package report
+
+import (
+	"bytes"
+	"encoding/xml"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRenderJUnit(t *testing.T) {
+	assertions := assert.New(t)
+	tmplData := &Data{
+		ReportTitle: "test-title",
+		RunInfo:     []*RunInfoEntry{{Name: "go.version", Value: "go1.16"}, {Name: "env", Value: "staging"}},
+		TestResults: []*TestGroup{
+			{
+				PackageName: "go-test-report",
+				TestResults: []*TestStatus{
+					{
+						TestName:    "TestFunc1",
+						Package:     "go-test-report",
+						ElapsedTime: 1.25,
+						Output:      []string{"=== RUN   TestFunc1\n", "--- PASS: TestFunc1 (1.25s)\n"},
+						Passed:      true,
+						Omitted:     true,
+					},
+					{
+						TestName:     "TestFunc1/Sub(sub title)",
+						Package:      "go-test-report",
+						ElapsedTime:  1.25,
+						Output:       []string{"=== RUN   TestFunc1/Sub\n", "--- PASS: TestFunc1/Sub (1.25s)\n"},
+						Passed:       true,
+						TestFileName: "sample_file_1.go",
+						TestFunctionDetail: TestFunctionFilePos{
+							Line: 101,
+							Col:  1,
+						},
+					},
+					{
+						TestName:    "TestFunc2",
+						Package:     "go-test-report",
+						ElapsedTime: 0.5,
+						Output:      []string{"=== RUN   TestFunc2\n", "expected <a> & <b>\n", "--- FAIL: TestFunc2 (0.50s)\n"},
+					},
+				},
+			},
+			{
+				PackageName: "package2",
+				TestResults: []*TestStatus{
+					{
+						TestName: "TestFunc3",
+						Package:  "package2",
+						Output:   []string{"=== RUN   TestFunc3\n", "--- SKIP: TestFunc3 (0.00s)\n"},
+						Skipped:  true,
+					},
+				},
+			},
+		},
+	}
+	buffer := bytes.NewBufferString("")
+	err := RenderJUnit(tmplData, buffer)
+	assertions.Nil(err)
+
+	suites := &junitTestSuites{}
+	assertions.Nil(xml.Unmarshal(buffer.Bytes(), suites))
+	assertions.Equal("test-title", suites.Name)
+	assertions.Equal(3, suites.Tests)
+	assertions.Equal(1, suites.Failures)
+	assertions.Equal(1, suites.Skipped)
+	assertions.Equal("1.750", suites.Time)
+	assertions.Len(suites.Suites, 2)
+
+	suite := suites.Suites[0]
+	assertions.Equal("go-test-report", suite.Name)
+	assertions.Equal(2, suite.Tests)
+	assertions.Equal(1, suite.Failures)
+	assertions.Len(suite.TestCases, 2)
+	assertions.Equal([]*junitProperty{{Name: "go.version", Value: "go1.16"}, {Name: "env", Value: "staging"}}, suite.Properties.Properties)
+	assertions.Equal("TestFunc1/Sub(sub title)", suite.TestCases[0].Name)
+	assertions.Equal("go-test-report", suite.TestCases[0].ClassName)
+	assertions.Equal("1.250", suite.TestCases[0].Time)
+	assertions.Equal("sample_file_1.go", suite.TestCases[0].File)
+	assertions.Equal(101, suite.TestCases[0].Line)
+	assertions.Nil(suite.TestCases[0].Failure)
+	assertions.Equal("TestFunc2", suite.TestCases[1].Name)
+	assertions.NotNil(suite.TestCases[1].Failure)
+	assertions.Equal("=== RUN   TestFunc2\nexpected <a> & <b>\n--- FAIL: TestFunc2 (0.50s)\n", suite.TestCases[1].Failure.Body)
+
+	suite = suites.Suites[1]
+	assertions.Equal("package2", suite.Name)
+	assertions.Equal(1, suite.Skipped)
+	assertions.NotNil(suite.TestCases[0].Skipped)
+	assertions.Nil(suite.TestCases[0].Failure)
+}
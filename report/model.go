@@ -0,0 +1,188 @@
+// Package report turns the output of "go test -json" into test reports: ParseEvents reads the test events,
+// FormatTests applies the gunit titles and log records to the tests, Build computes the report data, and the
+// renderers write it as a self-contained HTML page, JUnit XML or JSON.
+package report
+
+import (
+	"fmt"
+	"html/template"
+	"strings"
+	"time"
+)
+
+type (
+	// goTestOutputRow is an event of the "go test -json" output.
+	goTestOutputRow struct {
+		Time       string
+		TestName   string `json:"Test"`
+		Action     string
+		Package    string
+		Elapsed    float64
+		Output     string
+		ImportPath string
+	}
+
+	// TestStatus is the result of a test, or of the package itself for the synthetic "[package]" test.
+	TestStatus struct {
+		TestName           string
+		Package            string
+		ElapsedTime        float64
+		Output             []string
+		Passed             bool
+		Skipped            bool
+		Omitted            bool
+		TestFileName       string
+		TestFunctionDetail TestFunctionFilePos
+		Title              string
+		LogEntries         []*LogEntry
+		PackageLevel       bool
+		Interrupted        bool
+		InterruptReason    string
+		Shard              string
+		History            *TestHistory
+		Attempts           []*TestAttempt
+		Flaky              bool
+		Assertions         []*AssertionDiff
+		Requests           []*APIRequest
+		// Intervals holds the periods during which the test was running, i.e. not paused by t.Parallel().
+		Intervals []*RunInterval
+		// Sequence is the rank of the test in the go test output, used to sort the report in source order.
+		Sequence int
+		// outputTimes holds the time of the event of each line of Output, the zero time if unknown.
+		outputTimes []time.Time
+	}
+
+	// TestAttempt is a single run of a test that ran several times, e.g. with "go test -count=N".
+	TestAttempt struct {
+		Passed      bool
+		Skipped     bool
+		ElapsedTime float64
+		Output      []string
+	}
+
+	// RunInterval is a period during which a test was running.
+	RunInterval struct {
+		Start time.Time
+		End   time.Time
+	}
+
+	// LogEntry is a structured gunit log record captured from the output of a test.
+	LogEntry struct {
+		Time    time.Time
+		Level   string
+		Message string
+		Fields  map[string]interface{}
+	}
+
+	// Data is the data model of a report, shared by the renderers. The options, e.g. ReportTitle or SortOrder (one
+	// of SortOrders), are set by the caller and the results by Build. A zero ReportTime stands for the current time.
+	Data struct {
+		TestResultGroupIndicatorWidth  string
+		TestResultGroupIndicatorHeight string
+		TestResults                    []*TestGroup
+		NumOfTestPassed                int
+		NumOfTestFailed                int
+		NumOfTestSkipped               int
+		NumOfTestInterrupted           int
+		NumOfTestFlaky                 int
+		NumOfTests                     int
+		TestDuration                   time.Duration
+		ReportTitle                    string
+		JsCode                         template.JS
+		NumOfTestsPerGroup             int
+		SortOrder                      string
+		ReportTime                     time.Time
+		OutputFilename                 string
+		TestExecutionDate              string
+		FailedTestNames                []string
+		BuildFailedPackages            []string
+		Trend                          *HistoryTrend
+		Diff                           *Diff
+		Timelines                      []*PackageTimeline
+		RunInfo                        []*RunInfoEntry
+	}
+
+	// TestGroup holds the tests of a package.
+	TestGroup struct {
+		FailureIndicator string
+		SkippedIndicator string
+		PackageName      string
+		ElapsedTime      float64
+		TestResults      []*TestStatus
+	}
+
+	// TestFunctionFilePos is the position of a test function in its file.
+	TestFunctionFilePos struct {
+		Line int
+		Col  int
+	}
+
+	// TestFileDetail is the location of a test function.
+	TestFileDetail struct {
+		FileName            string
+		TestFunctionFilePos TestFunctionFilePos
+	}
+
+	// TestFileDetails holds the location of the test functions by package and function name.
+	TestFileDetails map[string]map[string]*TestFileDetail
+)
+
+const (
+	interruptReasonTimeout = "timeout"
+	interruptReasonPanic   = "panic"
+)
+
+// packageTestName is the name of the synthetic test holding the package level events of a failed package.
+const packageTestName = "[package]"
+
+// appendOutput appends output lines written at the given time.
+func (s *TestStatus) appendOutput(eventTime time.Time, lines ...string) {
+	s.Output = append(s.Output, lines...)
+	for range lines {
+		s.outputTimes = append(s.outputTimes, eventTime)
+	}
+}
+
+// startInterval records that the test started or resumed running.
+func (s *TestStatus) startInterval(start time.Time) {
+	if len(s.Intervals) > 0 && s.Intervals[len(s.Intervals)-1].End.IsZero() {
+		return
+	}
+	s.Intervals = append(s.Intervals, &RunInterval{Start: start})
+}
+
+// endInterval records that the test finished or was paused.
+func (s *TestStatus) endInterval(end time.Time) {
+	if len(s.Intervals) > 0 && s.Intervals[len(s.Intervals)-1].End.IsZero() {
+		s.Intervals[len(s.Intervals)-1].End = end
+	}
+}
+
+// BaseTestName returns the name of the test as reported by go test, without the gunit title suffix.
+func (s *TestStatus) BaseTestName() string {
+	if s.Title == "" {
+		return s.TestName
+	}
+	return strings.TrimSuffix(s.TestName, fmt.Sprintf("(%s)", s.Title))
+}
+
+// IsTopLevel reports whether the test is neither a subtest nor the synthetic package test; the durations of top
+// level tests add up to the duration of their package.
+func (s *TestStatus) IsTopLevel() bool {
+	return !s.PackageLevel && !strings.Contains(s.BaseTestName(), "/")
+}
+
+// MergeAttempts records an earlier run of the same test, e.g. from another shard, as attempts of s. As with
+// "go test -count=N", the result of s stays the one of its last attempt, and s is flaky if its attempts disagree.
+func (s *TestStatus) MergeAttempts(earlier *TestStatus) {
+	s.Attempts = append(earlier.attempts(), s.attempts()...)
+	s.Flaky = s.Flaky || earlier.Flaky || hasDisagreeingAttempts(s.Attempts)
+}
+
+// attempts returns the attempts of the test, or its result as a single attempt if it ran once.
+func (s *TestStatus) attempts() []*TestAttempt {
+	if len(s.Attempts) > 0 {
+		return s.Attempts
+	}
+	return []*TestAttempt{newTestAttempt(s)}
+}
@@ -0,0 +1,70 @@
+package report
+
+import "sort"
+
+// the orders of the packages in the report, and of the tests in each package (--sort)
+const (
+	SortAlphabetical  = "alphabetical"
+	SortFailuresFirst = "failures-first"
+	SortSlowestFirst  = "slowest-first"
+	// SortSource is the order of the go test output, i.e. the order the tests ran in.
+	SortSource = "source"
+)
+
+// SortOrders are the valid values of Data.SortOrder.
+var SortOrders = []string{SortAlphabetical, SortFailuresFirst, SortSlowestFirst, SortSource}
+
+// sortTests sorts the tests of a package. Ties are broken by name, so that the order never depends on map iteration.
+func sortTests(tests []*TestStatus, order string) {
+	sort.SliceStable(tests, func(i, j int) bool {
+		switch order {
+		case SortFailuresFirst:
+			if isFailedTest(tests[i]) != isFailedTest(tests[j]) {
+				return isFailedTest(tests[i])
+			}
+		case SortSlowestFirst:
+			if tests[i].ElapsedTime != tests[j].ElapsedTime {
+				return tests[i].ElapsedTime > tests[j].ElapsedTime
+			}
+		case SortSource:
+			if tests[i].Sequence != tests[j].Sequence {
+				return tests[i].Sequence < tests[j].Sequence
+			}
+		}
+		return tests[i].TestName < tests[j].TestName
+	})
+}
+
+// sortTestGroups sorts the packages of the report, whose tests are already sorted.
+func sortTestGroups(groups []*TestGroup, order string) {
+	firstSequence := func(group *TestGroup) int {
+		sequence := -1
+		for _, status := range group.TestResults {
+			if sequence < 0 || status.Sequence < sequence {
+				sequence = status.Sequence
+			}
+		}
+		return sequence
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		switch order {
+		case SortFailuresFirst:
+			if groups[i].FailureIndicator != groups[j].FailureIndicator {
+				return groups[i].FailureIndicator == "failed"
+			}
+		case SortSlowestFirst:
+			if groups[i].ElapsedTime != groups[j].ElapsedTime {
+				return groups[i].ElapsedTime > groups[j].ElapsedTime
+			}
+		case SortSource:
+			if sequenceI, sequenceJ := firstSequence(groups[i]), firstSequence(groups[j]); sequenceI != sequenceJ {
+				return sequenceI < sequenceJ
+			}
+		}
+		return groups[i].PackageName < groups[j].PackageName
+	})
+}
+
+func isFailedTest(status *TestStatus) bool {
+	return !status.Passed && !status.Skipped
+}
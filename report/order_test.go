@@ -0,0 +1,105 @@
+package report
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestSortTests(t *testing.T) {
+	assertions := assert.New(t)
+	newTests := func() []*TestStatus {
+		return []*TestStatus{
+			{TestName: "TestC", Passed: true, ElapsedTime: 1, Sequence: 0},
+			{TestName: "TestA", Passed: true, ElapsedTime: 3, Sequence: 2},
+			{TestName: "TestB", ElapsedTime: 2, Sequence: 1},
+			{TestName: "TestD", Skipped: true, ElapsedTime: 3, Sequence: 3},
+		}
+	}
+	testNames := func(tests []*TestStatus) []string {
+		var names []string
+		for _, status := range tests {
+			names = append(names, status.TestName)
+		}
+		return names
+	}
+	for order, expected := range map[string][]string{
+		SortAlphabetical:  {"TestA", "TestB", "TestC", "TestD"},
+		SortFailuresFirst: {"TestB", "TestA", "TestC", "TestD"},
+		SortSlowestFirst:  {"TestA", "TestD", "TestB", "TestC"},
+		SortSource:        {"TestC", "TestB", "TestA", "TestD"},
+	} {
+		tests := newTests()
+		sortTests(tests, order)
+		assertions.Equal(expected, testNames(tests), order)
+	}
+}
+
+func TestSortTestGroups(t *testing.T) {
+	assertions := assert.New(t)
+	newGroups := func() []*TestGroup {
+		return []*TestGroup{
+			{PackageName: "c", FailureIndicator: "failed", ElapsedTime: 1, TestResults: []*TestStatus{{Sequence: 4}}},
+			{PackageName: "a", ElapsedTime: 2, TestResults: []*TestStatus{{Sequence: 2}, {Sequence: 1}}},
+			{PackageName: "b", ElapsedTime: 3, TestResults: []*TestStatus{{Sequence: 3}}},
+		}
+	}
+	packageNames := func(groups []*TestGroup) []string {
+		var names []string
+		for _, group := range groups {
+			names = append(names, group.PackageName)
+		}
+		return names
+	}
+	for order, expected := range map[string][]string{
+		SortAlphabetical:  {"a", "b", "c"},
+		SortFailuresFirst: {"c", "a", "b"},
+		SortSlowestFirst:  {"b", "a", "c"},
+		SortSource:        {"a", "b", "c"},
+	} {
+		groups := newGroups()
+		sortTestGroups(groups, order)
+		assertions.Equal(expected, packageNames(groups), order)
+	}
+}
+
+func TestBuildIsReproducible(t *testing.T) {
+	assertions := assert.New(t)
+	generate := func() []byte {
+		tmplData := &Data{
+			TestResultGroupIndicatorWidth:  "20px",
+			TestResultGroupIndicatorHeight: "16px",
+			ReportTitle:                    "test-title",
+			NumOfTestsPerGroup:             2,
+			SortOrder:                      SortSource,
+			ReportTime:                     time.Date(2020, 7, 10, 6, 24, 44, 0, time.UTC),
+		}
+		testsInPackages := map[string]map[string]*TestStatus{}
+		for i, name := range []string{"b.TestB", "a.TestA", "b.TestC", "c.TestD", "a.TestE"} {
+			packageName, testName := name[:1], name[2:]
+			if testsInPackages[packageName] == nil {
+				testsInPackages[packageName] = map[string]*TestStatus{}
+			}
+			testsInPackages[packageName][name] = &TestStatus{
+				TestName:    testName,
+				Package:     packageName,
+				Passed:      i%2 == 0,
+				ElapsedTime: 0.1,
+				Sequence:    i,
+			}
+		}
+		Build(tmplData, testsInPackages, nil, TestFileDetails{}, time.Second)
+		report := &bytes.Buffer{}
+		err := RenderHTML(tmplData, report)
+		assertions.Nil(err)
+		assertions.Equal("July 10, 2020 06:24:44", tmplData.TestExecutionDate)
+		assertions.Equal("b", tmplData.TestResults[0].PackageName)
+		assertions.Equal("TestC", tmplData.TestResults[0].TestResults[1].TestName)
+		return report.Bytes()
+	}
+	first := generate()
+	for i := 0; i < 5; i++ {
+		assertions.Equal(first, generate())
+	}
+}
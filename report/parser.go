@@ -0,0 +1,385 @@
+package report
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"github.com/smarty/gunit"
+	"go/types"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// maxTestEventSize is the maximum size of a single "go test -json" line; gunit logs of API tests can be large.
+const maxTestEventSize = 16 * 1024 * 1024
+
+type (
+	// ParseOptions are the options of ParseEvents.
+	ParseOptions struct {
+		// StripANSI removes the terminal escape sequences, such as colors, from the output of the tests.
+		StripANSI bool
+		// Echo, if not nil, receives a copy of each line read, e.g. to show the go test output while it is parsed.
+		Echo io.Writer
+	}
+
+	// Results are the test results parsed from "go test -json" output.
+	Results struct {
+		PackageNames map[string]*types.Nil
+		// Tests holds the tests by "<package>.<test>" key.
+		Tests map[string]*TestStatus
+		// FailedTestNames holds the keys of the failed tests, leaving out the parents of failed subtests.
+		FailedTestNames     []string
+		BuildFailedPackages []string
+	}
+)
+
+// ParseEvents reads the "go test -json" output of a test run. Tests that started but never finished are interrupted,
+// and the failures of a package outside of its tests are reported as a synthetic "[package]" test.
+func ParseEvents(reader io.Reader, options ParseOptions) (*Results, error) {
+	eventScanner := newTestEventScanner(reader)
+	allTests := map[string]*TestStatus{}
+	allPackageNames := map[string]*types.Nil{}
+	buildFailedPackageNames := map[string]*types.Nil{}
+	packageStatuses := map[string]*TestStatus{}
+	failedPackageNames := map[string]*types.Nil{}
+	runningTestNames := map[string]*types.Nil{}
+	lastFailedTestNames := map[string]string{}
+
+	parentFailedTestNames := []string{}
+	subFailedTestNames := []string{}
+	var lastEventTime time.Time
+
+	// read and parse "go test" results
+	for eventScanner.Scan() {
+		lineInput := eventScanner.Bytes()
+
+		if options.Echo != nil {
+			newline := []byte("\n")
+			if _, err := options.Echo.Write(append(lineInput, newline[0])); err != nil {
+				return nil, err
+			}
+		}
+		if len(bytes.TrimSpace(lineInput)) == 0 {
+			continue
+		}
+		goTestOutputRow := &goTestOutputRow{}
+		if err := json.Unmarshal(lineInput, goTestOutputRow); err != nil {
+			return nil, err
+		}
+		goTestOutputRow.TestName = filterTestName(goTestOutputRow.TestName)
+		if options.StripANSI {
+			goTestOutputRow.Output = stripANSI(goTestOutputRow.Output)
+		}
+		if packageName, failed := isBuildFailure(goTestOutputRow); failed {
+			buildFailedPackageNames[packageName] = nil
+		}
+
+		eventTime, timeErr := time.Parse(time.RFC3339Nano, goTestOutputRow.Time)
+		if timeErr == nil && eventTime.After(lastEventTime) {
+			lastEventTime = eventTime
+		}
+
+		if goTestOutputRow.TestName != "" {
+			var status *TestStatus
+			key := goTestOutputRow.Package + "." + goTestOutputRow.TestName
+			if _, exists := allTests[key]; !exists {
+				status = &TestStatus{
+					TestName: goTestOutputRow.TestName,
+					Package:  goTestOutputRow.Package,
+					Output:   []string{},
+					Sequence: len(allTests),
+				}
+				allTests[key] = status
+			} else {
+				status = allTests[key]
+			}
+			if goTestOutputRow.Action == "run" {
+				if len(status.Attempts) > 0 {
+					// the test runs again, e.g. with -count=N: its status is the one of its last attempt
+					status.Passed = false
+					status.Skipped = false
+					status.Omitted = false
+					status.ElapsedTime = 0
+					status.Output = []string{}
+					status.outputTimes = nil
+				}
+				runningTestNames[key] = nil
+			}
+			if goTestOutputRow.Action == "pass" || goTestOutputRow.Action == "fail" || goTestOutputRow.Action == "skip" {
+				delete(runningTestNames, key)
+				isParentTest := !strings.Contains(goTestOutputRow.TestName, "/")
+				if goTestOutputRow.Action == "fail" {
+					lastFailedTestNames[goTestOutputRow.Package] = key
+					if isParentTest {
+						parentFailedTestNames = append(parentFailedTestNames, key)
+					} else {
+						subFailedTestNames = append(subFailedTestNames, key)
+					}
+				}
+
+				if goTestOutputRow.Action == "pass" {
+					status.Passed = true
+					if isParentTest {
+						status.Omitted = true
+					}
+				}
+				if goTestOutputRow.Action == "skip" {
+					status.Skipped = true
+				}
+				status.ElapsedTime = goTestOutputRow.Elapsed
+			}
+			if timeErr == nil {
+				switch goTestOutputRow.Action {
+				case "run", "cont":
+					status.startInterval(eventTime)
+				case "pause", "pass", "fail", "skip":
+					status.endInterval(eventTime)
+				}
+			}
+			allPackageNames[goTestOutputRow.Package] = nil
+
+			status.appendOutput(eventTime, goTestOutputRow.Output)
+			if goTestOutputRow.Action == "pass" || goTestOutputRow.Action == "fail" || goTestOutputRow.Action == "skip" {
+				status.Attempts = append(status.Attempts, newTestAttempt(status))
+			}
+		} else if packageName := rowPackageName(goTestOutputRow); packageName != "" {
+			// events without a test name belong to the package itself (build output, TestMain, panics, ...)
+			status, exists := packageStatuses[packageName]
+			if !exists {
+				status = &TestStatus{
+					TestName:     packageTestName,
+					Package:      packageName,
+					Output:       []string{},
+					PackageLevel: true,
+				}
+				packageStatuses[packageName] = status
+			}
+			switch goTestOutputRow.Action {
+			case "pass":
+				status.Passed = true
+				status.ElapsedTime = goTestOutputRow.Elapsed
+			case "skip":
+				status.Skipped = true
+				status.ElapsedTime = goTestOutputRow.Elapsed
+			case "fail":
+				failedPackageNames[packageName] = nil
+				status.ElapsedTime = goTestOutputRow.Elapsed
+			}
+			if goTestOutputRow.Output != "" {
+				status.appendOutput(eventTime, goTestOutputRow.Output)
+			}
+		}
+	}
+
+	if err := eventScanner.Err(); err != nil {
+		return nil, err
+	}
+
+	// tests that started but never finished were interrupted, e.g. by a timeout or a panic in another test
+	var runningKeys []string
+	for key := range runningTestNames {
+		runningKeys = append(runningKeys, key)
+	}
+	sort.Strings(runningKeys)
+	interruptedTestNames := map[string][]string{}
+	for _, key := range runningKeys {
+		status := allTests[key]
+		status.Interrupted = true
+		status.endInterval(lastEventTime)
+		status.Attempts = append(status.Attempts, newTestAttempt(status))
+		interruptedTestNames[status.Package] = append(interruptedTestNames[status.Package], key)
+		if strings.Contains(status.TestName, "/") {
+			subFailedTestNames = append(subFailedTestNames, key)
+		} else {
+			parentFailedTestNames = append(parentFailedTestNames, key)
+		}
+	}
+	// a test that ran several times may have failed before passing in a later attempt, or the other way around
+	parentFailedTestNames = lastAttemptFailedTestNames(parentFailedTestNames, allTests)
+	subFailedTestNames = lastAttemptFailedTestNames(subFailedTestNames, allTests)
+	for _, status := range allTests {
+		status.Flaky = hasDisagreeingAttempts(status.Attempts)
+		if len(status.Attempts) < 2 {
+			status.Attempts = nil
+		}
+	}
+	for packageName, packageStatus := range packageStatuses {
+		attachPanicTrace(packageStatus, allTests, interruptedTestNames[packageName], lastFailedTestNames[packageName])
+	}
+
+	failedTestNames := []string{}
+
+	for _, parentName := range parentFailedTestNames {
+		for _, subName := range subFailedTestNames {
+			if strings.HasPrefix(subName, parentName) {
+				allTests[parentName].Omitted = true
+			}
+		}
+		if allTests[parentName].Omitted != true {
+			failedTestNames = append(failedTestNames, parentName)
+		}
+	}
+
+	failedTestNames = append(failedTestNames, subFailedTestNames...)
+
+	buildFailedPackages := []string{}
+	for packageName := range buildFailedPackageNames {
+		buildFailedPackages = append(buildFailedPackages, packageName)
+		failedPackageNames[packageName] = nil
+	}
+	sort.Strings(buildFailedPackages)
+
+	// a failed package is only reported on its own if none of its tests explains the failure
+	packagesWithFailedTests := map[string]*types.Nil{}
+	for _, key := range failedTestNames {
+		packagesWithFailedTests[allTests[key].Package] = nil
+	}
+	var packageNames []string
+	for packageName := range failedPackageNames {
+		if _, exists := packagesWithFailedTests[packageName]; !exists {
+			packageNames = append(packageNames, packageName)
+		}
+	}
+	sort.Strings(packageNames)
+	for _, packageName := range packageNames {
+		key := packageName + "." + packageTestName
+		packageStatuses[packageName].Sequence = len(allTests)
+		allTests[key] = packageStatuses[packageName]
+		failedTestNames = append(failedTestNames, key)
+	}
+
+	return &Results{
+		PackageNames:        allPackageNames,
+		Tests:               allTests,
+		FailedTestNames:     failedTestNames,
+		BuildFailedPackages: buildFailedPackages,
+	}, nil
+}
+
+// newTestAttempt returns the result of the current attempt of a test.
+func newTestAttempt(status *TestStatus) *TestAttempt {
+	return &TestAttempt{
+		Passed:      status.Passed,
+		Skipped:     status.Skipped,
+		ElapsedTime: status.ElapsedTime,
+		Output:      append([]string{}, status.Output...),
+	}
+}
+
+// hasDisagreeingAttempts returns true if a test both passed and failed in the given attempts.
+func hasDisagreeingAttempts(attempts []*TestAttempt) bool {
+	passed, failed := false, false
+	for _, attempt := range attempts {
+		if attempt.Passed {
+			passed = true
+		} else if !attempt.Skipped {
+			failed = true
+		}
+	}
+	return passed && failed
+}
+
+// lastAttemptFailedTestNames removes the duplicates and the tests whose last attempt did not fail from the given
+// failed test names.
+func lastAttemptFailedTestNames(failedTestNames []string, allTests map[string]*TestStatus) []string {
+	result := []string{}
+	seen := map[string]*types.Nil{}
+	for _, key := range failedTestNames {
+		status := allTests[key]
+		if _, exists := seen[key]; exists || status.Passed || status.Skipped {
+			continue
+		}
+		seen[key] = nil
+		result = append(result, key)
+	}
+	return result
+}
+
+// attachPanicTrace appends the panic found in the package level output to the tests that were running when it
+// occurred: the interrupted tests, or else the test that failed last. Tests whose own output already holds the
+// panic are left untouched.
+func attachPanicTrace(packageStatus *TestStatus, allTests map[string]*TestStatus, interruptedTestNames []string, lastFailedTestName string) {
+	trace := panicTrace(packageStatus.Output)
+	if len(trace) == 0 {
+		return
+	}
+	reason := interruptReasonPanic
+	if strings.HasPrefix(trace[0], "panic: test timed out") {
+		reason = interruptReasonTimeout
+	}
+	targets := interruptedTestNames
+	if len(targets) == 0 && lastFailedTestName != "" {
+		targets = []string{lastFailedTestName}
+	}
+	for _, key := range targets {
+		status := allTests[key]
+		if status.Interrupted {
+			status.InterruptReason = reason
+		}
+		if len(panicTrace(status.Output)) == 0 {
+			// the trace comes last, whatever the time of its lines
+			status.appendOutput(time.Time{}, trace...)
+		}
+	}
+}
+
+// panicTrace returns the output lines starting at the first "panic: " line, up to the final FAIL line of the package.
+func panicTrace(output []string) []string {
+	for i, line := range output {
+		if !strings.HasPrefix(line, "panic: ") {
+			continue
+		}
+		trace := output[i:]
+		for j, traceLine := range trace {
+			if strings.HasPrefix(traceLine, "FAIL\t") {
+				return trace[:j]
+			}
+		}
+		return trace
+	}
+	return nil
+}
+
+// isBuildFailure reports whether the row signals that a package (or its test binary) could not be built, in which
+// case none of its tests were run.
+func isBuildFailure(row *goTestOutputRow) (packageName string, failed bool) {
+	if row.Action == "build-fail" {
+		return rowPackageName(row), true
+	}
+	if row.TestName == "" && row.Action == "output" &&
+		(strings.HasSuffix(row.Output, "[build failed]\n") || strings.HasSuffix(row.Output, "[setup failed]\n")) {
+		return row.Package, true
+	}
+	return "", false
+}
+
+// rowPackageName returns the package of the row; build events only carry the import path of the package being
+// built, which looks like "pkg [pkg.test]" for test binaries.
+func rowPackageName(row *goTestOutputRow) string {
+	if row.Package != "" {
+		return row.Package
+	}
+	return strings.SplitN(row.ImportPath, " ", 2)[0]
+}
+
+func filterTestName(name string) (out string) {
+	out = name
+	parts := strings.Split(name, "/"+gunit.FixtureParallel)
+	if len(parts) == 2 {
+		if parts[1] == "" {
+			out = parts[0]
+		} else {
+			out = parts[0] + parts[1]
+		}
+	}
+	return out
+}
+
+// newTestEventScanner creates a line scanner for "go test -json" output.
+func newTestEventScanner(reader io.Reader) *bufio.Scanner {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxTestEventSize)
+	return scanner
+}
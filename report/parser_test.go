@@ -0,0 +1,247 @@
+package report
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestParseEvents(t *testing.T) {
+	assertions := assert.New(t)
+	data := `{"Time":"2020-07-10T01:24:44.269511-05:00","Action":"run","Package":"go-test-report","Test":"TestFunc1"}
+{"Time":"2020-07-10T01:24:44.270071-05:00","Action":"output","Package":"go-test-report","Test":"TestFunc1","Output":"=== RUN   TestFunc1\n"}
+{"Time":"2020-07-10T01:24:44.270295-05:00","Action":"output","Package":"go-test-report","Test":"TestFunc1","Output":"--- PASS: TestFunc1 (1.25s)\n"}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"pass","Package":"go-test-report","Test":"TestFunc1","Elapsed":1.25}
+{"Time":"2020-07-10T01:24:44.269511-05:00","Action":"run","Package":"package2","Test":"TestFunc2"}
+{"Time":"2020-07-10T01:24:44.270071-05:00","Action":"output","Package":"package2","Test":"TestFunc2","Output":"=== RUN   TestFunc2\n"}
+{"Time":"2020-07-10T01:24:44.270295-05:00","Action":"output","Package":"package2","Test":"TestFunc2","Output":"--- PASS: TestFunc2 (0.25s)\n"}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"pass","Package":"package2","Test":"TestFunc2","Elapsed":0.25}
+{"Time":"2020-07-10T01:24:44.269511-05:00","Action":"run","Package":"go-test-report","Test":"TestFunc3"}
+{"Time":"2020-07-10T01:24:44.270071-05:00","Action":"output","Package":"go-test-report","Test":"TestFunc3","Output":"=== RUN   TestFunc3\n"}
+{"Time":"2020-07-10T01:24:44.270071-05:00","Action":"output","Package":"go-test-report","Test":"TestFunc3","Output":"sample output\n"}
+{"Time":"2020-07-10T01:24:44.270295-05:00","Action":"output","Package":"go-test-report","Test":"TestFunc3","Output":"--- FAIL: TestFunc3 (0.00s)\n"}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"fail","Package":"go-test-report","Test":"TestFunc3","Elapsed":0}
+{"Time":"2021-11-10T21:28:34.882842+08:00","Action":"output","Package":"command-line-arguments","Test":"TestRoomReport2TransCode/Test3Report10Success","Output":"{\"level\":\"info\",\"Test\":\"TestRoomReport2TransCode/Test3Report10Success\",\"time\":\"2021-11-10T21:28:34+08:00\",\"message\":\"resp:[{ID:90762 AppID:LS RoomID:LS:1636550819514658 SessionID:LS:1636551159 CdnID:TXCLOUD FlowRatio:1 PullURL:rtmp://rtmp-tx.livestream.bugvanisher.com/live/33872_id-test-1636550819514658-1636551159 PushURL:rtmp://push-tx.livestream.bugvanisher.com/live/33872_id-test-1636550819514658-1636551159?txSecret=01365d8fd909f78fbbfe90f8c171993c\u0026txTime=618FBD65\u0026pushDomain=push-tx.livestream.bugvanisher.com\u0026cdnID=TXCLOUD\u0026session_id=1636551159 TranscodeTpl: TransferAddr:10.144.25.67:8080 Status:1 StartTime:1636550885902 DispatchTimes:0 CTime:1636550885902 MTime:1636550907033 DomainID:1 QualityLevelID:10 TaskQualityLevelID:0 TaskType:2 ClientIP: CostMode:1 SrcCdnID:TXCLOUD SrcDomainID:1 TaskSrc:1 EndTime:0 BrokenTimes:0 BrokenDuration:0 Bitrate:0 Fps:0 Delay:0 PacketLossRate:0 HealthDegree:0} {ID:90764 AppID:LS RoomID:LS:1636550819514658 SessionID:LS:1636551159 CdnID:TXCLOUD FlowRatio:1 PullURL:rtmp://rtmp-tx.livestream.s"}
+{"Time":"2021-11-10T21:28:34.883377+08:00","Action":"output","Package":"command-line-arguments","Test":"TestRoomReport2TransCode/Test3Report10Success","Output":"hopee.com/live/33872_id-test-1636550819514658-1636551159_hd PushURL:rtmp://push-tx.livestream.bugvanisher.com/live/33872_id-test-1636550819514658-1636551159_hd?txSecret=1144f8a67dfe64ae3f36d8e5a7ce507d\u0026txTime=618FBD7D\u0026pushDomain=push-tx.livestream.bugvanisher.com\u0026cdnID=TXCLOUD\u0026session_id=1636551159 TranscodeTpl:hd TransferAddr:- Status:1 StartTime:1636550909745 DispatchTimes:1 CTime:1636550909747 MTime:1636550909747 DomainID:1 QualityLevelID:10 TaskQualityLevelID:10 TaskType:0 ClientIP: CostMode:1 SrcCdnID:TXCLOUD SrcDomainID:1 TaskSrc:1 EndTime:0 BrokenTimes:0 BrokenDuration:0 Bitrate:0 Fps:0 Delay:0 PacketLossRate:0 HealthDegree:0} {ID:90765 AppID:LS RoomID:LS:1636550819514658 SessionID:LS:1636551159 CdnID:TXCLOUD FlowRatio:1 PullURL:rtmp://rtmp-tx.livestream.bugvanisher.com/live/33872_id-test-1636550819514658-1636551159_sd PushURL:rtmp://push-tx.livestream.bugvanisher.com/live/33872_id-test-1636550819514658-1636551159_sd?txSecret=625c6a314de35e6a80f6e2ff7eeb3e3e\u0026txTime=618FBD7D\u0026pushDomain=push-tx.livestream.bugvanisher.com\u0026cdnID=T"}
+{"Time":"2021-11-10T21:28:34.88985+08:00","Action":"output","Package":"command-line-arguments","Test":"TestRoomReport2TransCode/Test3Report10Success","Output":"XCLOUD\u0026session_id=1636551159 TranscodeTpl:sd TransferAddr:- Status:1 StartTime:1636550909745 DispatchTimes:1 CTime:1636550909749 MTime:1636550909749 DomainID:1 QualityLevelID:10 TaskQualityLevelID:20 TaskType:0 ClientIP: CostMode:1 SrcCdnID:TXCLOUD SrcDomainID:1 TaskSrc:1 EndTime:0 BrokenTimes:0 BrokenDuration:0 Bitrate:0 Fps:0 Delay:0 PacketLossRate:0 HealthDegree:0} {ID:90763 AppID:LS RoomID:LS:1636550819514658 SessionID:LS:1636551159 CdnID:TXCLOUD FlowRatio:1 PullURL:rtmp://rtmp-tx.livestream.bugvanisher.com/live/33872_id-test-1636550819514658-1636551159_flu PushURL:rtmp://push-tx.livestream.bugvanisher.com/live/33872_id-test-1636550819514658-1636551159_flu?txSecret=57332a1862c318d74e899e9891cf4051\u0026txTime=618FBD69\u0026pushDomain=push-tx.livestream.bugvanisher.com\u0026cdnID=TXCLOUD\u0026session_id=1636551159 TranscodeTpl:flu TransferAddr:- Status:1 StartTime:1636550889754 DispatchTimes:1 CTime:1636550889756 MTime:1636550907033 DomainID:1 QualityLevelID:10 TaskQualityLevelID:30 TaskType:0 ClientIP: CostMode:1 SrcCdnID:TXCLOUD SrcDomain"}
+{"Time":"2021-11-10T21:28:34.895624+08:00","Action":"output","Package":"command-line-arguments","Test":"TestRoomReport2TransCode/Test3Report10Success","Output":"ID:1 TaskSrc:1 EndTime:0 BrokenTimes:0 BrokenDuration:0 Bitrate:0 Fps:0 Delay:0 PacketLossRate:0 HealthDegree:0}]\"}\n"}
+{"Time":"2021-11-10T21:28:34.895651+08:00","Action":"output","Package":"command-line-arguments","Test":"TestRoomReport2TransCode/Test3Report10Success","Output":"{\"Test\":\"TestRoomReport2TransCode\",\"time\":\"2021-11-10T21:28:34+08:00\",\"message\":\"try to delete roomId:1636550819514658\"}\n"}
+`
+	results, err := ParseEvents(strings.NewReader(data), ParseOptions{})
+	assertions.Nil(err)
+	allPackageNames, allTests := results.PackageNames, results.Tests
+	FormatTests(allTests, nil)
+	assertions.Len(allPackageNames, 3)
+	assertions.Contains(allPackageNames, "go-test-report")
+	assertions.Contains(allPackageNames, "package2")
+	assertions.Len(allTests, 4)
+	assertions.Contains(allTests, "go-test-report.TestFunc1")
+	assertions.Contains(allTests, "package2.TestFunc2")
+	assertions.Contains(allTests, "go-test-report.TestFunc3")
+
+	val := allTests["go-test-report.TestFunc1"]
+	assertions.True(val.Passed)
+	assertions.Equal("TestFunc1", val.TestName)
+	assertions.Equal(1.25, val.ElapsedTime)
+	assertions.Len(val.Output, 2)
+	assertions.Equal("=== RUN   TestFunc1\n", val.Output[0])
+	assertions.Equal("--- PASS: TestFunc1 (1.25s)\n", val.Output[1])
+	assertions.Equal(0, val.TestFunctionDetail.Line)
+	assertions.Equal(0, val.TestFunctionDetail.Col)
+
+	val = allTests["package2.TestFunc2"]
+	assertions.True(val.Passed)
+	assertions.Equal("TestFunc2", val.TestName)
+	assertions.Equal(0.25, val.ElapsedTime)
+	assertions.Len(val.Output, 2)
+	assertions.Equal("=== RUN   TestFunc2\n", val.Output[0])
+	assertions.Equal("--- PASS: TestFunc2 (0.25s)\n", val.Output[1])
+	assertions.Equal(0, val.TestFunctionDetail.Line)
+	assertions.Equal(0, val.TestFunctionDetail.Col)
+
+	val = allTests["go-test-report.TestFunc3"]
+	assertions.False(val.Passed)
+	assertions.Equal("TestFunc3", val.TestName)
+	assertions.Equal(0.00, val.ElapsedTime)
+	assertions.Len(val.Output, 3)
+	assertions.Equal("=== RUN   TestFunc3\n", val.Output[0])
+	assertions.Equal("--- FAIL: TestFunc3 (0.00s)\n", val.Output[2])
+	assertions.Equal(0, val.TestFunctionDetail.Line)
+	assertions.Equal(0, val.TestFunctionDetail.Col)
+}
+
+func TestSameTestName(t *testing.T) {
+	assertions := assert.New(t)
+	data := `{"Time":"2020-07-10T01:24:44.269511-05:00","Action":"run","Package":"foo","Test":"Test"}
+{"Time":"2020-07-10T01:24:44.270071-05:00","Action":"output","Package":"foo","Test":"Test","Output":"=== RUN   Test\n"}
+{"Time":"2020-07-10T01:24:44.270295-05:00","Action":"output","Package":"foo","Test":"Test","Output":"--- PASS: Test (1.5s)\n"}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"pass","Package":"foo","Test":"Test","Elapsed":1.5}
+{"Time":"2020-07-10T01:24:44.269511-05:00","Action":"run","Package":"bar","Test":"Test"}
+{"Time":"2020-07-10T01:24:44.270071-05:00","Action":"output","Package":"bar","Test":"Test","Output":"=== RUN   Test\n"}
+{"Time":"2020-07-10T01:24:44.270295-05:00","Action":"output","Package":"bar","Test":"Test","Output":"--- FAIL: Test (0.5s)\n"}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"fail","Package":"bar","Test":"Test","Elapsed":0.5}
+`
+	results, err := ParseEvents(strings.NewReader(data), ParseOptions{})
+	allPackageNames, allTests := results.PackageNames, results.Tests
+	assertions.Nil(err)
+	assertions.Len(allPackageNames, 2)
+	assertions.Contains(allPackageNames, "foo")
+	assertions.Contains(allPackageNames, "bar")
+	assertions.Len(allTests, 2)
+}
+
+func TestParseEventsWithBuildFailures(t *testing.T) {
+	assertions := assert.New(t)
+	data := `{"Time":"2020-07-10T01:24:44.269511-05:00","Action":"run","Package":"foo","Test":"TestFunc1"}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"pass","Package":"foo","Test":"TestFunc1","Elapsed":0}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"output","Package":"bar","Output":"FAIL\tbar [build failed]\n"}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"fail","Package":"bar","Elapsed":0}
+{"ImportPath":"baz [baz.test]","Action":"build-fail"}
+`
+	results, err := ParseEvents(strings.NewReader(data), ParseOptions{})
+	allTests, failedTestNames, buildFailedPackages := results.Tests, results.FailedTestNames, results.BuildFailedPackages
+	assertions.Nil(err)
+	assertions.Len(allTests, 3)
+	assertions.Equal([]string{"bar", "baz"}, buildFailedPackages)
+	assertions.Equal([]string{"bar.[package]", "baz.[package]"}, failedTestNames)
+
+	val := allTests["bar.[package]"]
+	assertions.True(val.PackageLevel)
+	assertions.False(val.Passed)
+	assertions.Equal("bar", val.Package)
+	assertions.Equal([]string{"FAIL\tbar [build failed]\n"}, val.Output)
+}
+
+func TestParseEventsWithPackageFailures(t *testing.T) {
+	assertions := assert.New(t)
+	data := `{"Time":"2020-07-10T01:24:44.269511-05:00","Action":"run","Package":"foo","Test":"TestFunc1"}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"fail","Package":"foo","Test":"TestFunc1","Elapsed":0}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"fail","Package":"foo","Elapsed":0.1}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"output","Package":"bar","Output":"panic: TestMain failed\n"}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"output","Package":"bar","Output":"FAIL\tbar\t0.010s\n"}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"fail","Package":"bar","Elapsed":0.01}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"output","Package":"baz","Output":"ok  \tbaz\t0.010s\n"}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"pass","Package":"baz","Elapsed":0.01}
+`
+	results, err := ParseEvents(strings.NewReader(data), ParseOptions{})
+	allTests, failedTestNames, buildFailedPackages := results.Tests, results.FailedTestNames, results.BuildFailedPackages
+	assertions.Nil(err)
+	assertions.Empty(buildFailedPackages)
+	assertions.Len(allTests, 2)
+	assertions.Contains(allTests, "foo.TestFunc1")
+	assertions.Contains(allTests, "bar.[package]")
+	assertions.Equal([]string{"foo.TestFunc1", "bar.[package]"}, failedTestNames)
+
+	val := allTests["bar.[package]"]
+	assertions.Equal(0.01, val.ElapsedTime)
+	assertions.Equal([]string{"panic: TestMain failed\n", "FAIL\tbar\t0.010s\n"}, val.Output)
+}
+
+func TestParseEventsWithTimeout(t *testing.T) {
+	assertions := assert.New(t)
+	data := `{"Time":"2020-07-10T01:24:44.269511-05:00","Action":"run","Package":"foo","Test":"TestFunc1"}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"pass","Package":"foo","Test":"TestFunc1","Elapsed":0}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"run","Package":"foo","Test":"TestFunc2"}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"output","Package":"foo","Test":"TestFunc2","Output":"=== RUN   TestFunc2\n"}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"run","Package":"foo","Test":"TestFunc2/Sub"}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"output","Package":"foo","Output":"panic: test timed out after 1s\n"}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"output","Package":"foo","Output":"goroutine 1 [running]:\n"}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"output","Package":"foo","Output":"FAIL\tfoo\t1.010s\n"}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"fail","Package":"foo","Elapsed":1.01}
+`
+	results, err := ParseEvents(strings.NewReader(data), ParseOptions{})
+	allTests, failedTestNames := results.Tests, results.FailedTestNames
+	assertions.Nil(err)
+	assertions.Len(allTests, 3)
+	assertions.Equal([]string{"foo.TestFunc2/Sub"}, failedTestNames)
+
+	val := allTests["foo.TestFunc1"]
+	assertions.False(val.Interrupted)
+
+	val = allTests["foo.TestFunc2"]
+	assertions.True(val.Interrupted)
+	assertions.True(val.Omitted)
+	assertions.Equal(interruptReasonTimeout, val.InterruptReason)
+
+	val = allTests["foo.TestFunc2/Sub"]
+	assertions.True(val.Interrupted)
+	assertions.False(val.Omitted)
+	assertions.Equal(interruptReasonTimeout, val.InterruptReason)
+	assertions.Contains(val.Output, "panic: test timed out after 1s\n")
+	assertions.Contains(val.Output, "goroutine 1 [running]:\n")
+	assertions.NotContains(val.Output, "FAIL\tfoo\t1.010s\n")
+}
+
+func TestParseEventsWithPanic(t *testing.T) {
+	assertions := assert.New(t)
+	data := `{"Time":"2020-07-10T01:24:44.269511-05:00","Action":"run","Package":"foo","Test":"TestFunc1"}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"output","Package":"foo","Test":"TestFunc1","Output":"--- FAIL: TestFunc1 (0.00s)\n"}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"fail","Package":"foo","Test":"TestFunc1","Elapsed":0}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"output","Package":"foo","Output":"panic: boom [recovered]\n"}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"output","Package":"foo","Output":"FAIL\tfoo\t0.010s\n"}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"fail","Package":"foo","Elapsed":0.01}
+`
+	results, err := ParseEvents(strings.NewReader(data), ParseOptions{})
+	allTests, failedTestNames := results.Tests, results.FailedTestNames
+	assertions.Nil(err)
+	assertions.Len(allTests, 1)
+	assertions.Equal([]string{"foo.TestFunc1"}, failedTestNames)
+
+	val := allTests["foo.TestFunc1"]
+	assertions.False(val.Interrupted)
+	assertions.Equal([]string{"", "--- FAIL: TestFunc1 (0.00s)\n", "", "panic: boom [recovered]\n"}, val.Output)
+}
+
+func TestParseEventsWithRetries(t *testing.T) {
+	assertions := assert.New(t)
+	data := `{"Time":"2020-07-10T01:24:44.269511-05:00","Action":"run","Package":"foo","Test":"TestFunc1"}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"output","Package":"foo","Test":"TestFunc1","Output":"--- FAIL: TestFunc1 (0.10s)\n"}
+{"Time":"2020-07-10T01:24:44.370311-05:00","Action":"fail","Package":"foo","Test":"TestFunc1","Elapsed":0.1}
+{"Time":"2020-07-10T01:24:44.370311-05:00","Action":"run","Package":"foo","Test":"TestFunc2"}
+{"Time":"2020-07-10T01:24:44.370311-05:00","Action":"fail","Package":"foo","Test":"TestFunc2","Elapsed":0}
+{"Time":"2020-07-10T01:24:44.370311-05:00","Action":"run","Package":"foo","Test":"TestFunc1"}
+{"Time":"2020-07-10T01:24:44.370311-05:00","Action":"output","Package":"foo","Test":"TestFunc1","Output":"--- PASS: TestFunc1 (0.20s)\n"}
+{"Time":"2020-07-10T01:24:44.570311-05:00","Action":"pass","Package":"foo","Test":"TestFunc1","Elapsed":0.2}
+{"Time":"2020-07-10T01:24:44.570311-05:00","Action":"run","Package":"foo","Test":"TestFunc2"}
+{"Time":"2020-07-10T01:24:44.570311-05:00","Action":"fail","Package":"foo","Test":"TestFunc2","Elapsed":0}
+`
+	results, err := ParseEvents(strings.NewReader(data), ParseOptions{})
+	allTests, failedTestNames := results.Tests, results.FailedTestNames
+	assertions.Nil(err)
+	assertions.Len(allTests, 2)
+	assertions.Equal([]string{"foo.TestFunc2"}, failedTestNames)
+
+	val := allTests["foo.TestFunc1"]
+	assertions.True(val.Passed)
+	assertions.True(val.Flaky)
+	assertions.Equal(0.2, val.ElapsedTime)
+	assertions.Equal([]string{"", "--- PASS: TestFunc1 (0.20s)\n", ""}, val.Output)
+	assertions.Len(val.Attempts, 2)
+	assertions.False(val.Attempts[0].Passed)
+	assertions.Equal(0.1, val.Attempts[0].ElapsedTime)
+	assertions.Equal([]string{"", "--- FAIL: TestFunc1 (0.10s)\n", ""}, val.Attempts[0].Output)
+	assertions.True(val.Attempts[1].Passed)
+
+	val = allTests["foo.TestFunc2"]
+	assertions.False(val.Passed)
+	assertions.False(val.Flaky)
+	assertions.Len(val.Attempts, 2)
+}
+
+func TestParseEventsWithStripANSI(t *testing.T) {
+	assertions := assert.New(t)
+	data := `{"Time":"2020-07-10T01:24:44.269511-05:00","Action":"run","Package":"foo","Test":"TestFunc1"}
+{"Time":"2020-07-10T01:24:44.270311-05:00","Action":"output","Package":"foo","Test":"TestFunc1","Output":"\u001b[1;31mERROR\u001b[0m request failed\u001b[K\n"}
+{"Time":"2020-07-10T01:24:44.370311-05:00","Action":"fail","Package":"foo","Test":"TestFunc1","Elapsed":0.1}
+`
+	for _, stripANSI := range []bool{false, true} {
+		results, err := ParseEvents(strings.NewReader(data), ParseOptions{StripANSI: stripANSI})
+		allTests := results.Tests
+		assertions.Nil(err)
+		if stripANSI {
+			assertions.Equal("ERROR request failed\n", allTests["foo.TestFunc1"].Output[1])
+		} else {
+			assertions.Equal("\x1b[1;31mERROR\x1b[0m request failed\x1b[K\n", allTests["foo.TestFunc1"].Output[1])
+		}
+	}
+}
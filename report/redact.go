@@ -0,0 +1,161 @@
+package report
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// redactedValue replaces the secrets found in the output of the tests.
+const redactedValue = "[REDACTED]"
+
+var (
+	// defaultRedactionPatterns match credentials whatever the context they are logged in.
+	defaultRedactionPatterns = []string{
+		`(?i)\bbearer\s+([A-Za-z0-9._~+/=-]+)`,
+		`(?i)\bbasic\s+([A-Za-z0-9+/]{8,}=*)`,
+		`\b(AKIA[0-9A-Z]{16})\b`,
+	}
+	// defaultRedactionKeys are the JSON keys and header names whose values are secrets. Generic names, e.g. token or
+	// key, are left to the RedactionRules, as they are common in output that is not secret, e.g. pagination tokens.
+	defaultRedactionKeys = []string{
+		"authorization", "proxy-authorization", "cookie", "set-cookie", "x-api-key", "api_key", "apikey",
+		"password", "passwd", "client_secret", "access_token", "refresh_token",
+	}
+	// defaultRedactionParams are the URL query parameters whose values are secrets, generic names aside as well.
+	defaultRedactionParams = []string{
+		"txSecret", "access_token", "refresh_token", "api_key", "apikey", "client_secret", "password",
+	}
+)
+
+// Redactor removes secrets from the output of the tests before it is embedded in the reports. A nil Redactor leaves
+// the output unchanged.
+type Redactor struct {
+	// patterns are applied to any text; only the capture groups of a pattern are redacted if it has any, its whole
+	// match otherwise.
+	patterns []*regexp.Regexp
+	keys     map[string]bool
+}
+
+// RedactionRules are the rules of a Redactor, which come along with the default rules unless NoDefaults is set.
+type RedactionRules struct {
+	// Patterns are regular expressions whose matches are redacted; only the capture groups of a pattern are redacted
+	// if it has any.
+	Patterns []string
+	// Keys are the JSON keys and header names, e.g. Authorization, whose values are redacted.
+	Keys []string
+	// Params are the URL query parameters, e.g. txSecret, whose values are redacted.
+	Params     []string
+	NoDefaults bool
+}
+
+// NewRedactor builds a Redactor from the given rules, along with the default rules unless they are disabled.
+func NewRedactor(rules RedactionRules) (*Redactor, error) {
+	patterns := rules.Patterns
+	keys := rules.Keys
+	params := rules.Params
+	if !rules.NoDefaults {
+		patterns = append(append([]string{}, defaultRedactionPatterns...), patterns...)
+		keys = append(append([]string{}, defaultRedactionKeys...), keys...)
+		params = append(append([]string{}, defaultRedactionParams...), params...)
+	}
+	r := &Redactor{keys: map[string]bool{}}
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid redaction pattern %q: %s", pattern, err)
+		}
+		r.patterns = append(r.patterns, re)
+	}
+	if len(keys) > 0 {
+		quotedKeys := make([]string, len(keys))
+		for i, key := range keys {
+			r.keys[strings.ToLower(key)] = true
+			quotedKeys[i] = regexp.QuoteMeta(key)
+		}
+		alternatives := strings.Join(quotedKeys, "|")
+		r.patterns = append(r.patterns,
+			// "key": "value", e.g. in a JSON body logged as text
+			regexp.MustCompile(`(?i)"(?:`+alternatives+`)"\s*:\s*"((?:[^"\\]|\\.)*)"`),
+			// key=value or key: value, e.g. a header, whose authentication scheme is kept
+			regexp.MustCompile(`(?i)(?:^|[^\w"-])(?:`+alternatives+`)\s*[:=]\s*(?:(?:bearer|basic|token)\s+)?([^\s,;&"']+)`))
+	}
+	if len(params) > 0 {
+		quotedParams := make([]string, len(params))
+		for i, param := range params {
+			quotedParams[i] = regexp.QuoteMeta(param)
+		}
+		r.patterns = append(r.patterns,
+			regexp.MustCompile(`(?i)[?&;](?:`+strings.Join(quotedParams, "|")+`)=([^&#\s"']*)`))
+	}
+	return r, nil
+}
+
+// redactText redacts the secrets of a line of output.
+func (r *Redactor) redactText(text string) string {
+	if r == nil {
+		return text
+	}
+	for _, pattern := range r.patterns {
+		text = redactMatches(pattern, text)
+	}
+	return text
+}
+
+// redactObject redacts the secrets of a gunit JSON log object in place: the values of the secret keys, at any depth,
+// and the secrets found in its strings.
+func (r *Redactor) redactObject(jsonObj map[string]interface{}) {
+	if r == nil {
+		return
+	}
+	for key, value := range jsonObj {
+		jsonObj[key] = r.redactValue(key, value)
+	}
+}
+
+func (r *Redactor) redactValue(key string, value interface{}) interface{} {
+	if r.keys[strings.ToLower(key)] && value != nil {
+		return redactedValue
+	}
+	switch v := value.(type) {
+	case string:
+		return r.redactText(v)
+	case map[string]interface{}:
+		r.redactObject(v)
+	case []interface{}:
+		for i, item := range v {
+			v[i] = r.redactValue("", item)
+		}
+	}
+	return value
+}
+
+func redactMatches(pattern *regexp.Regexp, text string) string {
+	matches := pattern.FindAllStringSubmatchIndex(text, -1)
+	if matches == nil {
+		return text
+	}
+	var builder strings.Builder
+	last := 0
+	for _, match := range matches {
+		spans := [][]int{match[:2]}
+		if len(match) > 2 {
+			spans = nil
+			for group := 1; group < len(match)/2; group++ {
+				if match[2*group] >= 0 && match[2*group+1] > match[2*group] {
+					spans = append(spans, match[2*group:2*group+2])
+				}
+			}
+		}
+		for _, span := range spans {
+			if span[0] < last {
+				continue
+			}
+			builder.WriteString(text[last:span[0]])
+			builder.WriteString(redactedValue)
+			last = span[1]
+		}
+	}
+	builder.WriteString(text[last:])
+	return builder.String()
+}
@@ -0,0 +1,16 @@
+package report
+
+import "io"
+
+// Renderer writes a report in a given format from its data, as computed by Build.
+type Renderer interface {
+	Render(tmplData *Data, writer io.Writer) error
+}
+
+// RendererFunc adapts a function to the Renderer interface, e.g. RendererFunc(RenderHTML).
+type RendererFunc func(tmplData *Data, writer io.Writer) error
+
+// Render calls f(tmplData, writer).
+func (f RendererFunc) Render(tmplData *Data, writer io.Writer) error {
+	return f(tmplData, writer)
+}
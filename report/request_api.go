@@ -0,0 +1,206 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/smarty/gunit"
+	"strings"
+	"time"
+)
+
+type (
+	// APIRequest is an HTTP call logged by a gunit API test, i.e. a log record with a RequestApi attribute.
+	APIRequest struct {
+		Time time.Time
+		// Name is the RequestApi attribute when it is a string, e.g. the name of the API.
+		Name            string
+		Method          string
+		URL             string
+		Headers         map[string]string
+		Body            string
+		Status          string
+		ResponseHeaders map[string]string
+		ResponseBody    string
+		Latency         string
+	}
+
+	// fieldSource looks up the attributes of a log record, whatever their case.
+	fieldSource map[string]interface{}
+)
+
+// apiRequests extracts the HTTP calls from the gunit log records of a test. The layout of the records is up to the
+// API tests, so the usual attribute names are looked for in the record itself, its RequestApi object, and its
+// request and response objects.
+func apiRequests(logEntries []*LogEntry) []*APIRequest {
+	var requests []*APIRequest
+	for _, entry := range logEntries {
+		if entry.Fields == nil {
+			continue
+		}
+		if _, ok := entry.Fields[gunit.RequestApi]; !ok {
+			continue
+		}
+		requests = append(requests, newAPIRequest(entry))
+	}
+	return requests
+}
+
+func newAPIRequest(entry *LogEntry) *APIRequest {
+	record := fieldSource(entry.Fields)
+	request := &APIRequest{Time: entry.Time}
+	var api fieldSource
+	switch value := entry.Fields[gunit.RequestApi].(type) {
+	case string:
+		request.Name = value
+	case map[string]interface{}:
+		api = value
+	}
+	requestSources := []fieldSource{record.object("request", "req"), api.object("request", "req"), api, record}
+	responseSources := []fieldSource{record.object("response", "resp", "res"), api.object("response", "resp", "res")}
+
+	request.Method = strings.ToUpper(firstText(requestSources, "method", "httpMethod"))
+	request.URL = firstText(requestSources, "url", "uri", "requestUrl", "path")
+	if request.URL == "" && (strings.HasPrefix(request.Name, "http") || strings.HasPrefix(request.Name, "/")) {
+		request.URL = request.Name
+	}
+	if host := firstText(requestSources, "baseUrl", "host"); host != "" && strings.HasPrefix(request.URL, "/") {
+		if !strings.Contains(host, "://") {
+			host = "http://" + host
+		}
+		request.URL = strings.TrimSuffix(host, "/") + request.URL
+	}
+	request.Headers = firstHeaders(requestSources, "headers", "header", "requestHeaders")
+	request.Body = firstBody(requestSources, "body", "requestBody", "data", "params")
+
+	request.Status = firstText(append(responseSources, api, record), "statusCode", "status", "httpStatus", "code")
+	request.ResponseHeaders = firstHeaders(responseSources, "headers", "header")
+	if request.ResponseHeaders == nil {
+		request.ResponseHeaders = firstHeaders([]fieldSource{api, record}, "responseHeaders")
+	}
+	request.ResponseBody = firstBody(responseSources, "body", "data")
+	if request.ResponseBody == "" {
+		request.ResponseBody = firstBody([]fieldSource{api, record}, "responseBody", "response", "resp")
+	}
+	for _, source := range append(responseSources, requestSources...) {
+		if latency, ok := source.lookup("latency", "elapsed", "duration", "cost", "costTime", "took", "latencyMs", "elapsedMs", "durationMs", "costMs"); ok {
+			request.Latency = formatLatency(latency.key, latency.value)
+			break
+		}
+	}
+	return request
+}
+
+type fieldValue struct {
+	key   string
+	value interface{}
+}
+
+// lookup returns the first attribute with one of the given names, ignoring the case.
+func (source fieldSource) lookup(names ...string) (fieldValue, bool) {
+	if source == nil {
+		return fieldValue{}, false
+	}
+	for _, name := range names {
+		if value, ok := source[name]; ok && value != nil {
+			return fieldValue{name, value}, true
+		}
+		for key, value := range source {
+			if strings.EqualFold(key, name) && value != nil {
+				return fieldValue{key, value}, true
+			}
+		}
+	}
+	return fieldValue{}, false
+}
+
+// object returns the first attribute with one of the given names that is a JSON object, or nil.
+func (source fieldSource) object(names ...string) fieldSource {
+	for _, name := range names {
+		if field, ok := source.lookup(name); ok {
+			if object, ok := field.value.(map[string]interface{}); ok {
+				return object
+			}
+		}
+	}
+	return nil
+}
+
+func firstText(sources []fieldSource, names ...string) string {
+	for _, source := range sources {
+		if field, ok := source.lookup(names...); ok {
+			switch value := field.value.(type) {
+			case string:
+				return value
+			case float64, bool:
+				return fmt.Sprint(value)
+			}
+		}
+	}
+	return ""
+}
+
+// firstHeaders returns the first headers found, either a JSON object of strings or a http.Header.
+func firstHeaders(sources []fieldSource, names ...string) map[string]string {
+	for _, source := range sources {
+		field, ok := source.lookup(names...)
+		if !ok {
+			continue
+		}
+		object, ok := field.value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		headers := map[string]string{}
+		for name, value := range object {
+			switch v := value.(type) {
+			case []interface{}:
+				values := make([]string, len(v))
+				for i, item := range v {
+					values[i] = fmt.Sprint(item)
+				}
+				headers[name] = strings.Join(values, ", ")
+			case string:
+				headers[name] = v
+			default:
+				headers[name] = fmt.Sprint(v)
+			}
+		}
+		return headers
+	}
+	return nil
+}
+
+// firstBody returns the first body found; bodies logged as JSON values are encoded back to JSON.
+func firstBody(sources []fieldSource, names ...string) string {
+	for _, source := range sources {
+		field, ok := source.lookup(names...)
+		if !ok {
+			continue
+		}
+		if body, ok := field.value.(string); ok {
+			return body
+		}
+		body, err := json.Marshal(field.value)
+		if err == nil {
+			return string(body)
+		}
+	}
+	return ""
+}
+
+// formatLatency formats a logged latency: strings are kept as is, numbers are milliseconds if the attribute name
+// says so, nanoseconds (an encoded time.Duration) if they are large integers, and seconds otherwise.
+func formatLatency(key string, value interface{}) string {
+	number, ok := value.(float64)
+	if !ok {
+		return fmt.Sprint(value)
+	}
+	switch {
+	case strings.HasSuffix(strings.ToLower(key), "ms"):
+		return fmt.Sprintf("%gms", number)
+	case number >= float64(time.Millisecond) && number == float64(int64(number)):
+		return time.Duration(int64(number)).String()
+	default:
+		return fmt.Sprintf("%gs", number)
+	}
+}
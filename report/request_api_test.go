@@ -0,0 +1,55 @@
+package report
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAPIRequests(t *testing.T) {
+	assertions := assert.New(t)
+	var flatFields, nestedFields map[string]interface{}
+	assertions.Nil(json.Unmarshal([]byte(`{
+		"RequestApi": "login",
+		"method": "post",
+		"host": "api.example.com",
+		"path": "/login",
+		"headers": {"Content-Type": ["application/json"], "X-Trace": "a1"},
+		"body": {"user": "bob"},
+		"statusCode": 200,
+		"responseBody": "{\"ok\":true}",
+		"latencyMs": 12.5
+	}`), &flatFields))
+	assertions.Nil(json.Unmarshal([]byte(`{
+		"RequestApi": {
+			"request": {"method": "GET", "url": "https://api.example.com/rooms/1"},
+			"response": {"status": "404 Not Found", "headers": {"Retry-After": ["5"]}, "body": "not found"},
+			"elapsed": 1500000000
+		}
+	}`), &nestedFields))
+	requests := apiRequests([]*LogEntry{
+		{Message: "login", Fields: flatFields},
+		{Message: "not a request", Fields: map[string]interface{}{"roomId": "42"}},
+		{Message: "room", Fields: nestedFields},
+	})
+	assertions.Len(requests, 2)
+
+	assertions.Equal(&APIRequest{
+		Name:         "login",
+		Method:       "POST",
+		URL:          "http://api.example.com/login",
+		Headers:      map[string]string{"Content-Type": "application/json", "X-Trace": "a1"},
+		Body:         `{"user":"bob"}`,
+		Status:       "200",
+		ResponseBody: `{"ok":true}`,
+		Latency:      "12.5ms",
+	}, requests[0])
+	assertions.Equal(&APIRequest{
+		Method:          "GET",
+		URL:             "https://api.example.com/rooms/1",
+		Status:          "404 Not Found",
+		ResponseHeaders: map[string]string{"Retry-After": "5"},
+		ResponseBody:    "not found",
+		Latency:         "1.5s",
+	}, requests[1])
+}
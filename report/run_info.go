@@ -0,0 +1,29 @@
+package report
+
+import "strings"
+
+// RunInfoCommit is the name of the run info entry of the git commit, which is also stored in the history runs.
+const RunInfoCommit = "git.commit"
+
+// RunInfoEntry is an entry of the "Run info" panel of the report, e.g. the git commit or the URL of the CI job.
+type RunInfoEntry struct {
+	Name  string
+	Value string
+}
+
+// runInfoMap returns the run info by name, for the JSON exports.
+func runInfoMap(entries []*RunInfoEntry) map[string]string {
+	if len(entries) == 0 {
+		return nil
+	}
+	runInfo := map[string]string{}
+	for _, entry := range entries {
+		runInfo[entry.Name] = entry.Value
+	}
+	return runInfo
+}
+
+// IsURL reports whether the value is a link, e.g. the URL of the CI job.
+func (entry *RunInfoEntry) IsURL() bool {
+	return strings.HasPrefix(entry.Value, "https://") || strings.HasPrefix(entry.Value, "http://")
+}
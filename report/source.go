@@ -0,0 +1,85 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+type (
+	goListJSONModule struct {
+		Path string
+		Dir  string
+		Main bool
+	}
+
+	goListJSON struct {
+		Dir         string
+		ImportPath  string
+		Name        string
+		GoFiles     []string
+		TestGoFiles []string
+		Module      goListJSONModule
+	}
+)
+
+// ReadTestFileDetails finds the test functions of the given packages with "go list", so that the report links the
+// tests to their source. The packages that are not available locally are left out.
+func ReadTestFileDetails(allPackageNames map[string]*types.Nil) (TestFileDetails, error) {
+	var out bytes.Buffer
+	var cmd *exec.Cmd
+	testFileDetailByPackage := TestFileDetails{}
+	stringReader := strings.NewReader("")
+	for packageName := range allPackageNames {
+		cmd = exec.Command("go", "list", "-json", packageName)
+		out.Reset()
+		stringReader.Reset("")
+		cmd.Stdin = stringReader
+		cmd.Stdout = &out
+		err := cmd.Run()
+		if err != nil {
+			// the package is not available locally, e.g. when rendering archived test results
+			continue
+		}
+		goListJSON := &goListJSON{}
+		if err := json.Unmarshal(out.Bytes(), goListJSON); err != nil {
+			return nil, err
+		}
+		testFileDetailByPackage[packageName] = map[string]*TestFileDetail{}
+		for _, file := range goListJSON.TestGoFiles {
+			sourceFilePath := fmt.Sprintf("%s/%s", goListJSON.Dir, file)
+			fileSet := token.NewFileSet()
+			f, err := parser.ParseFile(fileSet, sourceFilePath, nil, 0)
+			if err != nil {
+				return nil, err
+			}
+			ast.Inspect(f, func(n ast.Node) bool {
+				switch x := n.(type) {
+				case *ast.FuncDecl:
+					detail := &TestFileDetail{}
+					fileSetPos := fileSet.Position(n.Pos())
+					folders := strings.Split(fileSetPos.String(), "/")
+					fileNameWithPos := folders[len(folders)-1]
+					fileDetails := strings.Split(fileNameWithPos, ":")
+					lineNum, _ := strconv.Atoi(fileDetails[1])
+					colNum, _ := strconv.Atoi(fileDetails[2])
+					detail.FileName = fileDetails[0]
+					detail.TestFunctionFilePos = TestFunctionFilePos{
+						Line: lineNum,
+						Col:  colNum,
+					}
+					testFileDetailByPackage[packageName][x.Name.Name] = detail
+				}
+				return true
+			})
+		}
+	}
+	return testFileDetailByPackage, nil
+}
@@ -0,0 +1,146 @@
+package report
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// minTimelineBarWidth is the minimum width of a bar of the timeline, in percent, keeping very short runs visible.
+const minTimelineBarWidth = 0.2
+
+type (
+	// PackageTimeline is the timeline of the tests of a package, showing when each test was running.
+	PackageTimeline struct {
+		PackageName string
+		// Duration is the time between the start of the first test and the end of the last one, in seconds.
+		Duration float64
+		// MaxConcurrency is the largest number of tests without subtests running at the same time.
+		MaxConcurrency int
+		// Rows holds one row per test, by start time.
+		Rows []*TimelineRow
+	}
+
+	// TimelineRow is a test of a package timeline, along with the periods during which it was running.
+	TimelineRow struct {
+		TestName string
+		// Status is "passed", "failed" or "skipped".
+		Status string
+		Bars   []*TimelineBar
+	}
+
+	// TimelineBar is a period during which a test was running, positioned in percent of the package duration.
+	TimelineBar struct {
+		Left  float64
+		Width float64
+		Title string
+	}
+)
+
+// buildTimelines builds the timeline of each package from the running periods of its tests. Packages whose events
+// have no time are left out.
+func buildTimelines(testsInPackages map[string]map[string]*TestStatus) []*PackageTimeline {
+	var timelines []*PackageTimeline
+	for packageName, tests := range testsInPackages {
+		var statuses []*TestStatus
+		var start, end time.Time
+		for _, status := range tests {
+			if status.PackageLevel || len(status.Intervals) == 0 {
+				continue
+			}
+			statuses = append(statuses, status)
+			for _, interval := range status.Intervals {
+				if start.IsZero() || interval.Start.Before(start) {
+					start = interval.Start
+				}
+				if interval.End.After(end) {
+					end = interval.End
+				}
+			}
+		}
+		if len(statuses) == 0 {
+			continue
+		}
+		sort.Slice(statuses, func(i, j int) bool {
+			if !statuses[i].Intervals[0].Start.Equal(statuses[j].Intervals[0].Start) {
+				return statuses[i].Intervals[0].Start.Before(statuses[j].Intervals[0].Start)
+			}
+			return statuses[i].TestName < statuses[j].TestName
+		})
+		duration := end.Sub(start)
+		timeline := &PackageTimeline{
+			PackageName:    packageName,
+			Duration:       duration.Seconds(),
+			MaxConcurrency: maxConcurrency(statuses),
+		}
+		for _, status := range statuses {
+			row := &TimelineRow{
+				TestName: status.TestName,
+				Status:   jsonReportStatus(status.Passed, status.Skipped),
+			}
+			for _, interval := range status.Intervals {
+				bar := &TimelineBar{
+					Title: fmt.Sprintf("%s: started at +%.3fs, ran %.3fs", status.TestName,
+						interval.Start.Sub(start).Seconds(), interval.End.Sub(interval.Start).Seconds()),
+					Width: 100,
+				}
+				if duration > 0 {
+					bar.Left = 100 * float64(interval.Start.Sub(start)) / float64(duration)
+					bar.Width = 100 * float64(interval.End.Sub(interval.Start)) / float64(duration)
+				}
+				if bar.Width < minTimelineBarWidth {
+					bar.Width = minTimelineBarWidth
+				}
+				row.Bars = append(row.Bars, bar)
+			}
+			timeline.Rows = append(timeline.Rows, row)
+		}
+		timelines = append(timelines, timeline)
+	}
+	sort.Slice(timelines, func(i, j int) bool {
+		return timelines[i].PackageName < timelines[j].PackageName
+	})
+	return timelines
+}
+
+// maxConcurrency returns the largest number of tests running at the same time. Parents are left out, since they
+// wait for their parallel subtests.
+func maxConcurrency(statuses []*TestStatus) int {
+	type event struct {
+		time  time.Time
+		delta int
+	}
+	parentNames := map[string]bool{}
+	for _, status := range statuses {
+		name := status.BaseTestName()
+		for i := strings.LastIndex(name, "/"); i > 0; i = strings.LastIndex(name, "/") {
+			name = name[:i]
+			parentNames[name] = true
+		}
+	}
+	var events []event
+	for _, status := range statuses {
+		if parentNames[status.BaseTestName()] {
+			continue
+		}
+		for _, interval := range status.Intervals {
+			events = append(events, event{interval.Start, 1}, event{interval.End, -1})
+		}
+	}
+	// at the same time, the end of a test comes before the start of the next one
+	sort.Slice(events, func(i, j int) bool {
+		if !events[i].time.Equal(events[j].time) {
+			return events[i].time.Before(events[j].time)
+		}
+		return events[i].delta < events[j].delta
+	})
+	running, maxRunning := 0, 0
+	for _, e := range events {
+		running += e.delta
+		if running > maxRunning {
+			maxRunning = running
+		}
+	}
+	return maxRunning
+}
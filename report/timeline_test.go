@@ -0,0 +1,55 @@
+package report
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestBuildTimelines(t *testing.T) {
+	assertions := assert.New(t)
+	data := `{"Time":"2020-07-10T01:24:40Z","Action":"run","Package":"foo","Test":"TestFixture"}
+{"Time":"2020-07-10T01:24:40Z","Action":"run","Package":"foo","Test":"TestFixture/TestA"}
+{"Time":"2020-07-10T01:24:40Z","Action":"pause","Package":"foo","Test":"TestFixture/TestA"}
+{"Time":"2020-07-10T01:24:40Z","Action":"run","Package":"foo","Test":"TestFixture/TestB"}
+{"Time":"2020-07-10T01:24:40Z","Action":"pause","Package":"foo","Test":"TestFixture/TestB"}
+{"Time":"2020-07-10T01:24:41Z","Action":"cont","Package":"foo","Test":"TestFixture/TestA"}
+{"Time":"2020-07-10T01:24:41Z","Action":"cont","Package":"foo","Test":"TestFixture/TestB"}
+{"Time":"2020-07-10T01:24:43Z","Action":"fail","Package":"foo","Test":"TestFixture/TestB","Elapsed":2}
+{"Time":"2020-07-10T01:24:45Z","Action":"pass","Package":"foo","Test":"TestFixture/TestA","Elapsed":4}
+{"Time":"2020-07-10T01:24:45Z","Action":"fail","Package":"foo","Test":"TestFixture","Elapsed":5}
+{"Time":"2020-07-10T01:24:42Z","Action":"run","Package":"bar","Test":"TestHang"}
+{"Time":"2020-07-10T01:24:46Z","Action":"output","Package":"bar","Output":"panic: test timed out after 4s\n"}
+`
+	results, err := ParseEvents(strings.NewReader(data), ParseOptions{})
+	allTests := results.Tests
+	assertions.Nil(err)
+	testA := allTests["foo.TestFixture/TestA"]
+	assertions.Len(testA.Intervals, 2)
+	assertions.Equal("2020-07-10T01:24:41Z", testA.Intervals[1].Start.Format("2006-01-02T15:04:05Z07:00"))
+	assertions.Equal("2020-07-10T01:24:45Z", testA.Intervals[1].End.Format("2006-01-02T15:04:05Z07:00"))
+	// interrupted tests run until the last event
+	assertions.Equal("2020-07-10T01:24:46Z", allTests["bar.TestHang"].Intervals[0].End.Format("2006-01-02T15:04:05Z07:00"))
+
+	_, testsInPackages := FormatTests(allTests, nil)
+	timelines := buildTimelines(testsInPackages)
+	assertions.Len(timelines, 2)
+	assertions.Equal("bar", timelines[0].PackageName)
+	assertions.Equal(4.0, timelines[0].Duration)
+	assertions.Equal(100.0, timelines[0].Rows[0].Bars[0].Width)
+
+	timeline := timelines[1]
+	assertions.Equal(5.0, timeline.Duration)
+	assertions.Equal(2, timeline.MaxConcurrency)
+	assertions.Len(timeline.Rows, 3)
+	assertions.Equal("TestFixture", timeline.Rows[0].TestName)
+	assertions.Equal("TestFixture/TestA", timeline.Rows[1].TestName)
+	assertions.Equal(jsonReportStatusPassed, timeline.Rows[1].Status)
+	assertions.Equal(jsonReportStatusFailed, timeline.Rows[2].Status)
+	bars := timeline.Rows[2].Bars
+	assertions.Len(bars, 2)
+	assertions.Equal(minTimelineBarWidth, bars[0].Width)
+	assertions.Equal(20.0, bars[1].Left)
+	assertions.Equal(40.0, bars[1].Width)
+	assertions.Equal("TestFixture/TestB: started at +1.000s, ran 2.000s", bars[1].Title)
+}
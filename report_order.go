@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+	"github.com/bugVanisher/gunit-test-report/report"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// sourceDateEpochEnv is the environment variable of reproducible builds holding the time to use instead of the
+// current time, in seconds since the Unix epoch.
+const sourceDateEpochEnv = "SOURCE_DATE_EPOCH"
+
+func validateSortFlag(flags *cmdFlags) error {
+	for _, order := range report.SortOrders {
+		if flags.sortFlag == order {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid sort order %q; valid orders are: %s", flags.sortFlag, strings.Join(report.SortOrders, ", "))
+}
+
+// parseReportTime returns the fixed time of the report, from the timestamp flag (RFC 3339 or seconds since the Unix
+// epoch) or else from SOURCE_DATE_EPOCH. It returns the zero time if neither is set, the current time being used.
+func parseReportTime(flags *cmdFlags) (time.Time, error) {
+	timestamp := flags.timestampFlag
+	if timestamp == "" {
+		timestamp = os.Getenv(sourceDateEpochEnv)
+	}
+	if timestamp == "" {
+		return time.Time{}, nil
+	}
+	if seconds, err := strconv.ParseInt(timestamp, 10, 64); err == nil {
+		return time.Unix(seconds, 0).UTC(), nil
+	}
+	reportTime, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp %q: expected RFC 3339 or seconds since the Unix epoch", timestamp)
+	}
+	return reportTime, nil
+}
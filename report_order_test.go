@@ -0,0 +1,49 @@
+package main
+
+import (
+	"github.com/bugVanisher/gunit-test-report/report"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestValidateSortFlag(t *testing.T) {
+	assertions := assert.New(t)
+	assertions.Nil(validateSortFlag(&cmdFlags{sortFlag: report.SortSlowestFirst}))
+	err := validateSortFlag(&cmdFlags{sortFlag: "random"})
+	assertions.Error(err)
+	assertions.Equal(`invalid sort order "random"; valid orders are: alphabetical, failures-first, slowest-first, source`, err.Error())
+}
+
+func TestParseReportTime(t *testing.T) {
+	assertions := assert.New(t)
+	sourceDateEpoch, sourceDateEpochSet := os.LookupEnv(sourceDateEpochEnv)
+	defer func() {
+		if sourceDateEpochSet {
+			_ = os.Setenv(sourceDateEpochEnv, sourceDateEpoch)
+		} else {
+			_ = os.Unsetenv(sourceDateEpochEnv)
+		}
+	}()
+	assertions.Nil(os.Unsetenv(sourceDateEpochEnv))
+	reportTime, err := parseReportTime(&cmdFlags{})
+	assertions.Nil(err)
+	assertions.True(reportTime.IsZero())
+
+	reportTime, err = parseReportTime(&cmdFlags{timestampFlag: "1594362284"})
+	assertions.Nil(err)
+	assertions.Equal(time.Date(2020, 7, 10, 6, 24, 44, 0, time.UTC), reportTime)
+
+	reportTime, err = parseReportTime(&cmdFlags{timestampFlag: "2020-07-10T01:24:44-05:00"})
+	assertions.Nil(err)
+	assertions.True(time.Date(2020, 7, 10, 6, 24, 44, 0, time.UTC).Equal(reportTime))
+
+	_, err = parseReportTime(&cmdFlags{timestampFlag: "yesterday"})
+	assertions.Error(err)
+
+	assertions.Nil(os.Setenv(sourceDateEpochEnv, "1594362284"))
+	reportTime, err = parseReportTime(&cmdFlags{})
+	assertions.Nil(err)
+	assertions.Equal(time.Date(2020, 7, 10, 6, 24, 44, 0, time.UTC), reportTime)
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"github.com/bugVanisher/gunit-test-report/report"
+	"github.com/spf13/cobra"
+	"os/exec"
+)
+
+func initRunCommand(tmplData *report.Data, flags *cmdFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "run [flags] [-- go test flags] [packages]",
+		Short: "Runs go test and generates the report from its output",
+		Long: "Runs \"go test -json\" with the given arguments and generates the report from its output.\n" +
+			"Flags meant for go test must follow \"--\", e.g. \"go-test-report run -t title -- -count=1 ./...\".\n" +
+			"The command exits with the exit code of go test.",
+		Args: cobra.ArbitraryArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := initTemplateData(tmplData, flags); err != nil {
+				return err
+			}
+			cmd.SilenceUsage = true
+			goTestCmd := exec.Command("go", append([]string{"test", "-json"}, args...)...)
+			goTestCmd.Stderr = cmd.ErrOrStderr()
+			goTestStdout, err := goTestCmd.StdoutPipe()
+			if err != nil {
+				return err
+			}
+			if err := goTestCmd.Start(); err != nil {
+				return err
+			}
+			if err := generateReports(cmd, goTestStdout, tmplData, flags); err != nil {
+				// go test may be blocked writing to the pipe that is no longer read
+				_ = goTestCmd.Process.Kill()
+				_ = goTestCmd.Wait()
+				return err
+			}
+			if err := goTestCmd.Wait(); err != nil {
+				if exitErr, ok := err.(*exec.ExitError); ok {
+					return &exitCodeError{
+						code:    exitErr.ExitCode(),
+						message: fmt.Sprintf("go test exited with status %d", exitErr.ExitCode()),
+					}
+				}
+				return err
+			}
+			return checkFailOn(cmd, tmplData, flags)
+		},
+	}
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRunCommand(t *testing.T) {
+	assertions := assert.New(t)
+	moduleDir, err := ioutil.TempDir("", "go-test-report")
+	assertions.Nil(err)
+	defer func() {
+		_ = os.RemoveAll(moduleDir)
+	}()
+	files := map[string]string{
+		"go.mod": "module example.com/sample\n\ngo 1.13\n",
+		"sample_test.go": `package sample
+
+import "testing"
+
+func TestPassing(t *testing.T) {}
+
+func TestFailing(t *testing.T) {
+	t.Fatal("failed on purpose")
+}
+`,
+	}
+	for name, content := range files {
+		assertions.Nil(ioutil.WriteFile(filepath.Join(moduleDir, name), []byte(content), 0644))
+	}
+	workingDir, err := os.Getwd()
+	assertions.Nil(err)
+	assertions.Nil(os.Chdir(moduleDir))
+	defer func() {
+		_ = os.Chdir(workingDir)
+	}()
+
+	buffer := bytes.NewBufferString("")
+	rootCmd, tmplData, _ := initRootCommand()
+	rootCmd.SetOut(buffer)
+	rootCmd.SetErr(buffer)
+	rootCmd.SetArgs([]string{"run", "--output", "report.html", "--", "-count=1", "./..."})
+	rootCmdErr := rootCmd.Execute()
+	assertions.Error(rootCmdErr)
+	exitErr, ok := rootCmdErr.(*exitCodeError)
+	assertions.True(ok)
+	if ok {
+		assertions.Equal(1, exitErr.code)
+	}
+	assertions.FileExists(filepath.Join(moduleDir, "report.html"))
+	assertions.Equal(1, tmplData.NumOfTestFailed)
+}
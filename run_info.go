@@ -0,0 +1,201 @@
+package main
+
+import (
+	"fmt"
+	"github.com/bugVanisher/gunit-test-report/report"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// ciEnvironment lists the environment variables of a CI provider holding the information of the run; the first
+// variable set wins. The pull request variables of some providers hold "false" outside of pull requests.
+type ciEnvironment struct {
+	name        string
+	detect      string
+	jobURL      []string
+	buildNumber []string
+	pullRequest []string
+	branch      []string
+}
+
+var ciEnvironments = []*ciEnvironment{
+	{
+		name:        "GitHub Actions",
+		detect:      "GITHUB_ACTIONS",
+		buildNumber: []string{"GITHUB_RUN_NUMBER"},
+		branch:      []string{"GITHUB_HEAD_REF", "GITHUB_REF_NAME"},
+	},
+	{
+		name:        "GitLab CI",
+		detect:      "GITLAB_CI",
+		jobURL:      []string{"CI_JOB_URL"},
+		buildNumber: []string{"CI_PIPELINE_IID"},
+		pullRequest: []string{"CI_MERGE_REQUEST_IID"},
+		branch:      []string{"CI_MERGE_REQUEST_SOURCE_BRANCH_NAME", "CI_COMMIT_REF_NAME"},
+	},
+	{
+		name:        "Jenkins",
+		detect:      "JENKINS_URL",
+		jobURL:      []string{"BUILD_URL"},
+		buildNumber: []string{"BUILD_NUMBER"},
+		pullRequest: []string{"CHANGE_ID"},
+		branch:      []string{"CHANGE_BRANCH", "BRANCH_NAME", "GIT_BRANCH"},
+	},
+	{
+		name:        "CircleCI",
+		detect:      "CIRCLECI",
+		jobURL:      []string{"CIRCLE_BUILD_URL"},
+		buildNumber: []string{"CIRCLE_BUILD_NUM"},
+		pullRequest: []string{"CIRCLE_PR_NUMBER", "CIRCLE_PULL_REQUEST"},
+		branch:      []string{"CIRCLE_BRANCH"},
+	},
+	{
+		name:        "Travis CI",
+		detect:      "TRAVIS",
+		jobURL:      []string{"TRAVIS_JOB_WEB_URL"},
+		buildNumber: []string{"TRAVIS_BUILD_NUMBER"},
+		pullRequest: []string{"TRAVIS_PULL_REQUEST"},
+		branch:      []string{"TRAVIS_PULL_REQUEST_BRANCH", "TRAVIS_BRANCH"},
+	},
+	{
+		name:        "Buildkite",
+		detect:      "BUILDKITE",
+		jobURL:      []string{"BUILDKITE_BUILD_URL"},
+		buildNumber: []string{"BUILDKITE_BUILD_NUMBER"},
+		pullRequest: []string{"BUILDKITE_PULL_REQUEST"},
+		branch:      []string{"BUILDKITE_BRANCH"},
+	},
+}
+
+// collectRunInfo returns the information of the run shown in the "Run info" panel and written to the exports: git,
+// Go and host information, the CI job if any, then the --meta pairs, which replace the collected entries of the
+// same name.
+func collectRunInfo(flags *cmdFlags) ([]*report.RunInfoEntry, error) {
+	metaEntries, err := parseMetaFlag(flags)
+	if err != nil {
+		return nil, err
+	}
+	var entries []*report.RunInfoEntry
+	add := func(name, value string) {
+		if value != "" {
+			entries = append(entries, &report.RunInfoEntry{Name: name, Value: value})
+		}
+	}
+	ci := detectCIEnvironment(os.Getenv)
+	if commit := gitOutput("rev-parse", "HEAD"); commit != "" {
+		add(report.RunInfoCommit, commit)
+		branch := gitOutput("rev-parse", "--abbrev-ref", "HEAD")
+		if (branch == "" || branch == "HEAD") && ci != nil {
+			// CI providers usually check out a detached HEAD
+			branch = firstEnv(os.Getenv, ci.branch)
+		}
+		if branch != "HEAD" {
+			add("git.branch", branch)
+		}
+		// untracked files are ignored, otherwise the reports written by a previous run would make the worktree dirty
+		add("git.dirty", fmt.Sprint(gitOutput("status", "--porcelain", "--untracked-files=no") != ""))
+	}
+	add("go.version", goVersion())
+	add("go.os", runtime.GOOS)
+	add("go.arch", runtime.GOARCH)
+	if hostname, err := os.Hostname(); err == nil {
+		add("host", hostname)
+	}
+	if ci != nil {
+		add("ci.provider", ci.name)
+		add("ci.jobUrl", ciJobURL(os.Getenv, ci))
+		add("ci.buildNumber", firstEnv(os.Getenv, ci.buildNumber))
+		add("ci.pullRequest", ciPullRequest(os.Getenv, ci))
+	}
+	for _, metaEntry := range metaEntries {
+		replaced := false
+		for _, entry := range entries {
+			if entry.Name == metaEntry.Name {
+				entry.Value = metaEntry.Value
+				replaced = true
+			}
+		}
+		if !replaced {
+			entries = append(entries, metaEntry)
+		}
+	}
+	return entries, nil
+}
+
+// parseMetaFlag parses the key=value pairs of --meta.
+func parseMetaFlag(flags *cmdFlags) ([]*report.RunInfoEntry, error) {
+	var entries []*report.RunInfoEntry
+	for _, pair := range flags.metaFlag {
+		i := strings.Index(pair, "=")
+		if i < 1 {
+			return nil, fmt.Errorf("invalid meta %q: expected key=value", pair)
+		}
+		entries = append(entries, &report.RunInfoEntry{Name: pair[:i], Value: pair[i+1:]})
+	}
+	return entries, nil
+}
+
+func detectCIEnvironment(getenv func(string) string) *ciEnvironment {
+	for _, ci := range ciEnvironments {
+		if getenv(ci.detect) != "" {
+			return ci
+		}
+	}
+	return nil
+}
+
+func ciJobURL(getenv func(string) string, ci *ciEnvironment) string {
+	if ci.detect == "GITHUB_ACTIONS" {
+		server, repository, runID := getenv("GITHUB_SERVER_URL"), getenv("GITHUB_REPOSITORY"), getenv("GITHUB_RUN_ID")
+		if server == "" || repository == "" || runID == "" {
+			return ""
+		}
+		return fmt.Sprintf("%s/%s/actions/runs/%s", server, repository, runID)
+	}
+	return firstEnv(getenv, ci.jobURL)
+}
+
+func ciPullRequest(getenv func(string) string, ci *ciEnvironment) string {
+	if ci.detect == "GITHUB_ACTIONS" {
+		// e.g. "refs/pull/42/merge"
+		if ref := strings.Split(getenv("GITHUB_REF"), "/"); len(ref) == 4 && ref[1] == "pull" {
+			return ref[2]
+		}
+		return ""
+	}
+	pullRequest := firstEnv(getenv, ci.pullRequest)
+	if pullRequest == "false" {
+		return ""
+	}
+	// CIRCLE_PULL_REQUEST is the URL of the pull request
+	return pullRequest[strings.LastIndex(pullRequest, "/")+1:]
+}
+
+func firstEnv(getenv func(string) string, names []string) string {
+	for _, name := range names {
+		if value := getenv(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// gitOutput runs a git command in the working directory and returns its trimmed output, or "" if it failed.
+func gitOutput(args ...string) string {
+	output, err := exec.Command("git", args...).Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(output))
+}
+
+// goVersion returns the version of the go command running the tests, or else the version this tool was built with.
+func goVersion() string {
+	output, err := exec.Command("go", "env", "GOVERSION").Output()
+	if version := strings.TrimSpace(string(output)); err == nil && version != "" {
+		return version
+	}
+	return runtime.Version()
+}
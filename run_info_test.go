@@ -0,0 +1,69 @@
+package main
+
+import (
+	"github.com/bugVanisher/gunit-test-report/report"
+	"github.com/stretchr/testify/assert"
+	"runtime"
+	"testing"
+)
+
+func TestCollectRunInfo(t *testing.T) {
+	assertions := assert.New(t)
+	entries, err := collectRunInfo(&cmdFlags{metaFlag: []string{"go.os=plan9", "env=staging", "query=a=b"}})
+	assertions.Nil(err)
+	runInfo := map[string]string{}
+	for _, entry := range entries {
+		runInfo[entry.Name] = entry.Value
+	}
+	assertions.Equal(runtime.GOARCH, runInfo["go.arch"])
+	assertions.NotEmpty(runInfo["go.version"])
+	// the --meta pairs replace the collected entries of the same name, and come last otherwise
+	assertions.Equal("plan9", runInfo["go.os"])
+	assertions.Equal(&report.RunInfoEntry{Name: "query", Value: "a=b"}, entries[len(entries)-1])
+	assertions.Equal(&report.RunInfoEntry{Name: "env", Value: "staging"}, entries[len(entries)-2])
+
+	_, err = collectRunInfo(&cmdFlags{metaFlag: []string{"=staging"}})
+	assertions.Error(err)
+	assertions.Equal(`invalid meta "=staging": expected key=value`, err.Error())
+	_, err = collectRunInfo(&cmdFlags{metaFlag: []string{"staging"}})
+	assertions.Error(err)
+}
+
+func TestDetectCIEnvironment(t *testing.T) {
+	assertions := assert.New(t)
+	getenv := func(variables map[string]string) func(string) string {
+		return func(name string) string {
+			return variables[name]
+		}
+	}
+	assertions.Nil(detectCIEnvironment(getenv(nil)))
+
+	github := getenv(map[string]string{
+		"GITHUB_ACTIONS":    "true",
+		"GITHUB_SERVER_URL": "https://github.com",
+		"GITHUB_REPOSITORY": "bugVanisher/gunit-test-report",
+		"GITHUB_RUN_ID":     "1234",
+		"GITHUB_RUN_NUMBER": "56",
+		"GITHUB_REF":        "refs/pull/42/merge",
+	})
+	ci := detectCIEnvironment(github)
+	assertions.Equal("GitHub Actions", ci.name)
+	assertions.Equal("https://github.com/bugVanisher/gunit-test-report/actions/runs/1234", ciJobURL(github, ci))
+	assertions.Equal("56", firstEnv(github, ci.buildNumber))
+	assertions.Equal("42", ciPullRequest(github, ci))
+
+	circle := getenv(map[string]string{
+		"CIRCLECI":            "true",
+		"CIRCLE_BUILD_URL":    "https://circleci.com/gh/org/repo/78",
+		"CIRCLE_PULL_REQUEST": "https://github.com/org/repo/pull/9",
+	})
+	ci = detectCIEnvironment(circle)
+	assertions.Equal("CircleCI", ci.name)
+	assertions.Equal("https://circleci.com/gh/org/repo/78", ciJobURL(circle, ci))
+	assertions.Equal("9", ciPullRequest(circle, ci))
+
+	travis := getenv(map[string]string{"TRAVIS": "true", "TRAVIS_PULL_REQUEST": "false"})
+	ci = detectCIEnvironment(travis)
+	assertions.Equal("Travis CI", ci.name)
+	assertions.Empty(ciPullRequest(travis, ci))
+}
@@ -6,9 +6,88 @@
  * @property {Array.<string>} Output
  * @property {boolean} Passed
  * @property {boolean} Skipped
+ * @property {boolean} Interrupted
+ * @property {string} InterruptReason
+ * @property {string} Shard
+ * @property {string} Title
+ * @property {boolean} Omitted
+ * @property {TestHistory} History
+ * @property {Array.<TestAttempt>} Attempts The runs of a test that ran several times; its status is the one of the
+ *           last attempt.
+ * @property {boolean} Flaky
+ * @property {Array.<AssertionDiff>} Assertions The expected and actual values of the failed assertions.
+ * @property {Array.<LogEntry>} LogEntries The gunit JSON log records of the test.
+ * @property {Array.<ApiRequest>} Requests The HTTP calls logged by the test with gunit RequestApi records.
  */
 class TestStatus {}
 
+/**
+ * @typedef ApiRequest
+ * @property {string} Time
+ * @property {string} Name
+ * @property {string} Method
+ * @property {string} URL
+ * @property {Object.<string, string>} Headers
+ * @property {string} Body
+ * @property {string} Status
+ * @property {Object.<string, string>} ResponseHeaders
+ * @property {string} ResponseBody
+ * @property {string} Latency
+ */
+class ApiRequest {}
+
+/**
+ * @typedef LogEntry
+ * @property {string} Time
+ * @property {string} Level
+ * @property {string} Message
+ * @property {Object.<string, *>} Fields The other attributes of the log record.
+ */
+class LogEntry {}
+
+/**
+ * @typedef AssertionDiff
+ * @property {string} Expected
+ * @property {string} Actual
+ */
+class AssertionDiff {}
+
+/**
+ * @typedef DiffToken
+ * @property {string} text
+ * @property {boolean} changed True if the token is missing from the other value.
+ */
+class DiffToken {}
+
+/**
+ * @typedef AnsiSegment
+ * @property {string} text
+ * @property {Array.<string>} classes The classes of the text: "ansi-bold", "ansi-faint", "ansi-italic",
+ *           "ansi-underline", "ansi-fg-<0-15>" and "ansi-bg-<0-15>".
+ * @property {string} [color] The foreground color of 256 and 24-bit color codes, e.g. "rgb(255,135,0)".
+ * @property {string} [backgroundColor]
+ */
+class AnsiSegment {}
+
+/**
+ * @typedef TestAttempt
+ * @property {boolean} Passed
+ * @property {boolean} Skipped
+ * @property {number} ElapsedTime
+ * @property {Array.<string>} Output
+ */
+class TestAttempt {}
+
+/**
+ * @typedef TestHistory
+ * @property {Array.<?number>} Durations The durations of the test over the last runs, oldest first, or null for the
+ *           runs without the test.
+ * @property {Array.<string>} Statuses "passed", "failed", "skipped" or "" for each run.
+ * @property {string} FirstFailedAt The first run of the current failure streak of a failed test.
+ * @property {string} FirstFailedCommit
+ */
+class TestHistory {}
+
 /**
  * @typedef TestGroupData
  * @type {object}
@@ -31,11 +110,34 @@ class TestResults extends Array {}
  */
 class SelectedItems {}
 
+/**
+ * @typedef TestTreeNode
+ * @property {string} name The last segment of the test name.
+ * @property {number} index The index of the test in the test group, or -1 if go test did not report it.
+ * @property {TestStatus} testStatus
+ * @property {Array.<TestTreeNode>} children The subtests.
+ * @property {number} passed The number of passed tests in this subtree.
+ * @property {number} failed The number of failed tests in this subtree.
+ * @property {number} skipped The number of skipped tests in this subtree.
+ * @property {number} elapsedTime
+ */
+class TestTreeNode {}
+
+/**
+ * @typedef TestFilter
+ * @property {string} query The text searched in test names, gunit titles, packages and output.
+ * @property {Array.<string>} statuses The statuses of the shown tests: "passed", "failed" and/or "skipped".
+ */
+class TestFilter {}
+
 /**
  * @typedef GoTestReportElements
  * @property {TestResults} data
  * @property {HTMLElement} testResultsElem
  * @property {HTMLElement} testGroupListElem
+ * @property {HTMLInputElement} [searchInputElem]
+ * @property {NodeListOf<HTMLInputElement>} [statusFilterElems] Checkboxes whose data-status attribute is "passed",
+ *           "failed" or "skipped".
  */
 class GoTestReportElements {}
 
@@ -52,6 +154,16 @@ window.GoTestReport = function (elements) {
     selectedTestGroupColor: null
   }
 
+  const testStatusFilters = ['passed', 'failed', 'skipped']
+  let /**@type {TestFilter}*/ filterState = {query: '', statuses: testStatusFilters.slice()}
+  const searchTextCache = new WeakMap()
+  // beyond this number of token pairs, the differences of an assertion are not searched for common tokens
+  const maxDiffCells = 1000000
+  // the escape sequences of a terminal: CSI sequences, of which SGR ("m") sets the colors, and OSC sequences
+  const ansiEscapeRegex = /\u001b(?:\[([0-9;?]*)([@-~])|\][^\u0007\u001b]*(?:\u0007|\u001b\\))/g
+  const ansiColorLevels = [0, 95, 135, 175, 215, 255]
+  const logLevels = ['debug', 'info', 'warn', 'error']
+
   function addEventData(event) {
     if (event.data == null) {
       event.data = {target: event.target}
@@ -89,32 +201,23 @@ window.GoTestReport = function (elements) {
         || (data[testGroupId]['TestResults'] === undefined)) {
         return
       }
-      const testResults = /**@type {TestResults}*/ data[testGroupId]['TestResults']
-      let testGroupList = /**@type {string}*/ ''
       selectedItems.selectedTestGroupColor = getComputedStyle(target).getPropertyValue('background-color')
       selectedItems.testResults = target
       target.classList.add("selected")
-      for (let i = 0; i < testResults.length; i++) {
-        const testResult = /**@type {TestGroupData}*/ testResults[i]
-        const testPassed = /**@type {boolean}*/ testResult.Passed
-        const testSkipped = /**@type {boolean}*/ testResult.Skipped
-        const testPassedStatus = /**@type {string}*/ (testPassed) ? '' : (testSkipped ? 'skipped' : 'failed')
-        const testId = /**@type {string}*/ target.attributes['id'].value
-        testGroupList += `<div class="testGroupRow ${testPassedStatus}" data-groupid="${testId}" data-index="${i}">
-        <span class="testStatus ${testPassedStatus}">${(testPassed) ? '&check' : (testSkipped ? '&dash' : '&cross')};</span>
-        <span class="testTitle">${testResult.TestName}</span>
-        <span class="testDuration"><span>${testResult.ElapsedTime}s </span>⏱</span>
-      </div>`
-      }
+      const testId = /**@type {string}*/ target.attributes['id'].value
       const testGroupListElem = elements.testGroupListElem
-      testGroupListElem.innerHTML = ''
-      testGroupListElem.innerHTML = testGroupList
+      renderTestGroupList(data, [testId])
 
+      const testGroupRows = testGroupListElem.querySelectorAll('.testGroupRow')
       if (shiftKey) {
+        testGroupListElem.querySelectorAll('.testTreeChildren.collapsed')
+                         .forEach((elem) => elem.classList.remove('collapsed'))
+        testGroupListElem.querySelectorAll('.treeToggle')
+                         .forEach((elem) => elem.textContent = (elem.textContent === '') ? '' : '\u25be')
         testGroupListElem.querySelectorAll('.testGroupRow')
                          .forEach((elem) => testGroupListHandler(elem, data))
-      } else if (testResults.length === 1) {
-        testGroupListHandler(testGroupListElem.querySelector('.testGroupRow'), data)
+      } else if (testGroupRows.length === 1) {
+        testGroupListHandler(testGroupRows[0], data)
       }
     },
 
@@ -140,19 +243,67 @@ window.GoTestReport = function (elements) {
           testDetailDiv.classList.add('testDetail')
           const packageNameDiv = document.createElement('div')
           packageNameDiv.classList.add('package')
-          packageNameDiv.innerHTML = `<strong>Package:</strong> ${testStatus.Package}`
+          packageNameDiv.append(createLabelElement('Package:'), ` ${testStatus.Package}`)
           const testFileNameDiv = document.createElement('div')
           testFileNameDiv.classList.add('filename')
           if (testStatus.TestFileName.trim() === "") {
-            testFileNameDiv.innerHTML = `<strong>Filename:</strong> n/a &nbsp;&nbsp;`
+            testFileNameDiv.append(createLabelElement('Filename:'), ' n/a \u00a0\u00a0')
           } else {
-            testFileNameDiv.innerHTML = `<strong>Filename:</strong> ${testStatus.TestFileName} &nbsp;&nbsp;`
-            testFileNameDiv.innerHTML += `<strong>Line:</strong> ${testStatus.TestFunctionDetail.Line} `
-            testFileNameDiv.innerHTML += `<strong>Col:</strong> ${testStatus.TestFunctionDetail.Col}`
+            testFileNameDiv.append(createLabelElement('Filename:'), ` ${testStatus.TestFileName} \u00a0\u00a0`,
+                                   createLabelElement('Line:'), ` ${testStatus.TestFunctionDetail.Line} `,
+                                   createLabelElement('Col:'), ` ${testStatus.TestFunctionDetail.Col}`)
           }
           testDetailDiv.insertAdjacentElement('beforeend', packageNameDiv)
           testDetailDiv.insertAdjacentElement('beforeend', testFileNameDiv)
+          if (testStatus.Shard) {
+            const shardDiv = document.createElement('div')
+            shardDiv.classList.add('shard')
+            shardDiv.append(createLabelElement('Shard:'), ` ${testStatus.Shard}`)
+            testDetailDiv.insertAdjacentElement('beforeend', shardDiv)
+          }
+          if (testStatus.Interrupted === true) {
+            const interruptionDiv = document.createElement('div')
+            interruptionDiv.classList.add('interruption')
+            interruptionDiv.textContent = (testStatus.InterruptReason === 'timeout')
+              ? 'Interrupted: the test timed out before it finished'
+              : ((testStatus.InterruptReason === 'panic')
+                ? 'Interrupted: a panic stopped the test binary before the test finished'
+                : 'Interrupted: the test never reported a result')
+            testDetailDiv.insertAdjacentElement('beforeend', interruptionDiv)
+          }
+          if (testStatus.Attempts != null && testStatus.Attempts.length > 0) {
+            const attemptsDiv = document.createElement('div')
+            attemptsDiv.classList.add('attempts')
+            const attempts = testStatus.Attempts.map((attempt) =>
+              `${attempt.Passed ? '\u2713' : (attempt.Skipped ? '\u2010' : '\u2717')} ${attempt.ElapsedTime}s`)
+            attemptsDiv.append(createLabelElement('Attempts:'), ` ${attempts.join(', ')}`)
+            testDetailDiv.insertAdjacentElement('beforeend', attemptsDiv)
+          }
+          if (testStatus.History != null) {
+            testDetailDiv.insertAdjacentElement('beforeend', createTestHistoryElement(testStatus.History))
+          }
           testOutputDiv.insertAdjacentElement('afterbegin', consolePre)
+          const hasAssertions = testStatus.Assertions != null && testStatus.Assertions.length > 0
+          if (hasAssertions) {
+            // the assertion diffs replace the raw output, which remains available behind a toggle
+            testOutputDiv.insertAdjacentElement('afterbegin', createRawOutputToggle(consolePre))
+          }
+          if (testStatus.LogEntries != null && testStatus.LogEntries.length > 0) {
+            testOutputDiv.insertAdjacentElement('afterbegin', createLogEntriesElement(testStatus.LogEntries))
+          }
+          if (testStatus.Requests != null && testStatus.Requests.length > 0) {
+            const requestCardsDiv = document.createElement('div')
+            requestCardsDiv.classList.add('requestCards')
+            testStatus.Requests.forEach((request) => requestCardsDiv.appendChild(createRequestCardElement(request)))
+            testOutputDiv.insertAdjacentElement('afterbegin', requestCardsDiv)
+          }
+          if (hasAssertions) {
+            testStatus.Assertions
+                      .slice()
+                      .reverse()
+                      .forEach((assertion) =>
+                        testOutputDiv.insertAdjacentElement('afterbegin', createAssertionDiffElement(assertion)))
+          }
           testOutputDiv.insertAdjacentElement('beforeend', testDetailDiv)
           target.insertAdjacentElement('beforeend', testOutputDiv)
 
@@ -166,12 +317,921 @@ window.GoTestReport = function (elements) {
             consolePre.classList.remove('skipped')
             consolePre.classList.add('failed')
           }
-          consolePre.textContent = testStatus.Output.join('')
+          appendAnsiOutput(consolePre, testStatus.Output.join(''))
         } else {
           testOutputDiv.remove()
         }
       }
+    },
+
+    /**
+     * Builds the subtest tree of a test group from the "/" separated test names. Parents precede their subtests
+     * in the returned tree even if go test reported them in a different order.
+     * @param {Array.<TestStatus>} testResults
+     * @param {function(TestStatus): boolean} [include] If set, only the included tests and their parents are kept.
+     * @returns {Array.<TestTreeNode>} The top level tests.
+     */
+    buildTestTree: function (testResults, include) {
+      const roots = /**@type {Array.<TestTreeNode>}*/ []
+      const nodesByPath = new Map()
+      for (let i = 0; i < testResults.length; i++) {
+        const testResult = testResults[i]
+        const names = testBaseName(testResult).split('/')
+        let siblings = roots
+        let path = ''
+        for (let j = 0; j < names.length; j++) {
+          path = (j === 0) ? names[0] : `${path}/${names[j]}`
+          let node = nodesByPath.get(path)
+          if (node === undefined) {
+            node = {name: names[j], index: -1, testStatus: null, children: [], passed: 0, failed: 0, skipped: 0, elapsedTime: 0}
+            nodesByPath.set(path, node)
+            siblings.push(node)
+          }
+          if (j === names.length - 1) {
+            node.index = i
+            node.testStatus = testResult
+          }
+          siblings = node.children
+        }
+      }
+      const tree = (include === undefined) ? roots : pruneTestTree(roots, include)
+      tree.forEach(aggregateTestTreeNode)
+      return tree
+    },
+
+    /**
+     * Invoked when a user clicks on the expand/collapse toggle of a test with subtests.
+     * @param {Element} target The toggle element.
+     */
+    treeToggleHandler: function (target) {
+      const childrenElem = target.closest('.testTreeNode').querySelector(':scope > .testTreeChildren')
+      if (childrenElem != null) {
+        const collapsed = childrenElem.classList.toggle('collapsed')
+        target.textContent = collapsed ? '\u25b8' : '\u25be'
+      }
+    },
+
+    /**
+     * Computes the differences between the expected and actual values of an assertion, word by word.
+     * @param {string} expected
+     * @param {string} actual
+     * @returns {{expected: Array.<DiffToken>, actual: Array.<DiffToken>}} The tokens of both values; the tokens
+     *          missing from the other value are marked as changed.
+     */
+    diffAssertion: function (expected, actual) {
+      const expectedTokens = expected.match(/\w+|\s+|[^\w\s]/g) || []
+      const actualTokens = actual.match(/\w+|\s+|[^\w\s]/g) || []
+      let prefix = 0
+      while (prefix < expectedTokens.length && prefix < actualTokens.length
+             && expectedTokens[prefix] === actualTokens[prefix]) {
+        prefix++
+      }
+      let suffix = 0
+      while (suffix < expectedTokens.length - prefix && suffix < actualTokens.length - prefix
+             && expectedTokens[expectedTokens.length - 1 - suffix] === actualTokens[actualTokens.length - 1 - suffix]) {
+        suffix++
+      }
+      const expectedMiddle = expectedTokens.slice(prefix, expectedTokens.length - suffix)
+      const actualMiddle = actualTokens.slice(prefix, actualTokens.length - suffix)
+      const expectedCommon = new Array(expectedMiddle.length).fill(false)
+      const actualCommon = new Array(actualMiddle.length).fill(false)
+      if (expectedMiddle.length * actualMiddle.length <= maxDiffCells) {
+        // longest common subsequence of the tokens that differ
+        const lengths = []
+        for (let i = 0; i <= expectedMiddle.length; i++) {
+          lengths.push(new Array(actualMiddle.length + 1).fill(0))
+        }
+        for (let i = expectedMiddle.length - 1; i >= 0; i--) {
+          for (let j = actualMiddle.length - 1; j >= 0; j--) {
+            lengths[i][j] = (expectedMiddle[i] === actualMiddle[j])
+              ? lengths[i + 1][j + 1] + 1
+              : Math.max(lengths[i + 1][j], lengths[i][j + 1])
+          }
+        }
+        let i = 0
+        let j = 0
+        while (i < expectedMiddle.length && j < actualMiddle.length) {
+          if (expectedMiddle[i] === actualMiddle[j]) {
+            expectedCommon[i++] = true
+            actualCommon[j++] = true
+          } else if (lengths[i + 1][j] >= lengths[i][j + 1]) {
+            i++
+          } else {
+            j++
+          }
+        }
+      }
+      const toDiffTokens = (tokens, middleCommon) => tokens.map((text, i) => ({
+        text: text,
+        changed: (i >= prefix) && (i < tokens.length - suffix) && !middleCommon[i - prefix]
+      }))
+      return {
+        expected: toDiffTokens(expectedTokens, expectedCommon),
+        actual: toDiffTokens(actualTokens, actualCommon)
+      }
+    },
+
+    /**
+     * Splits the output of a test into segments styled by the ANSI SGR codes it contains, e.g. "\u001b[31m" for a
+     * red foreground. The other escape sequences are dropped.
+     * @param {string} text
+     * @returns {Array.<AnsiSegment>}
+     */
+    parseAnsiOutput: function (text) {
+      const segments = /**@type {Array.<AnsiSegment>}*/ []
+      let style = {classes: [], color: null, backgroundColor: null}
+      let lastIndex = 0
+      const addSegment = (segmentText) => {
+        if (segmentText.length === 0) {
+          return
+        }
+        const segment = {text: segmentText, classes: style.classes.slice()}
+        if (style.color != null) {
+          segment.color = style.color
+        }
+        if (style.backgroundColor != null) {
+          segment.backgroundColor = style.backgroundColor
+        }
+        segments.push(segment)
+      }
+      ansiEscapeRegex.lastIndex = 0
+      let match
+      while ((match = ansiEscapeRegex.exec(text)) !== null) {
+        addSegment(text.substring(lastIndex, match.index))
+        lastIndex = ansiEscapeRegex.lastIndex
+        if (match[2] === 'm') {
+          style = applySgrCodes(style, match[1])
+        }
+      }
+      addSegment(text.substring(lastIndex))
+      return segments
+    },
+
+    /**
+     * Formats an HTTP call as a curl command reproducing it.
+     * @param {ApiRequest} request
+     * @returns {string}
+     */
+    formatCurlCommand: function (request) {
+      const quote = (text) => `'${text.replace(/'/g, "'\\''")}'`
+      const method = request.Method || 'GET'
+      const args = ['curl']
+      if (method !== 'GET' || request.Body) {
+        args.push('-X', method)
+      }
+      args.push(quote(request.URL || ''))
+      Object.keys(request.Headers || {}).sort().forEach((name) => args.push('-H', quote(`${name}: ${request.Headers[name]}`)))
+      if (request.Body) {
+        args.push('--data-raw', quote(request.Body))
+      }
+      return args.join(' ')
+    },
+
+    /**
+     * Returns true if a test matches the search text and status filters.
+     * @param {TestStatus} testStatus
+     * @param {TestFilter} filter
+     * @returns {boolean}
+     */
+    matchesFilter: function (testStatus, filter) {
+      if (filter.statuses.indexOf(testStatusName(testStatus)) < 0) {
+        return false
+      }
+      const query = filter.query.trim().toLowerCase()
+      return (query === '') || testSearchText(testStatus).includes(query)
+    },
+
+    /**
+     * Applies a filter to the package tiles and the test list, and encodes it in the URL hash.
+     * @param {TestFilter} filter
+     */
+    applyFilter: function (filter) {
+      filterState = {query: filter.query, statuses: filter.statuses.slice()}
+      const active = isFilterActive()
+      const data = elements.data
+      elements.testResultsElem
+              .querySelectorAll('.testResultGroup')
+              .forEach((elem) => {
+                const testGroup = data[elem.id]
+                const visible = !active || (testGroup !== undefined && testGroup['TestResults'] !== undefined
+                  && testGroup['TestResults'].some((testStatus) => goTestReport.matchesFilter(testStatus, filterState)))
+                elem.classList.toggle('filteredOut', !visible)
+              })
+      if (selectedItems.testResults != null) {
+        renderTestGroupList(data, [selectedItems.testResults.id])
+      } else if (active) {
+        renderTestGroupList(data, Object.keys(data))
+      } else {
+        elements.testGroupListElem.textContent = ''
+      }
+      updateFilterInputs()
+
+      const hash = goTestReport.formatFilterHash(filterState)
+      if (window.location.hash !== hash) {
+        window.history.replaceState(null, '', (hash === '') ? window.location.pathname + window.location.search : hash)
+      }
+    },
+
+    /**
+     * Encodes a filter as a URL hash, e.g. "#q=timeout&status=failed"; the default filter is encoded as "".
+     * @param {TestFilter} filter
+     * @returns {string}
+     */
+    formatFilterHash: function (filter) {
+      const params = new URLSearchParams()
+      if (filter.query !== '') {
+        params.set('q', filter.query)
+      }
+      if (testStatusFilters.some((status) => filter.statuses.indexOf(status) < 0)) {
+        params.set('status', testStatusFilters.filter((status) => filter.statuses.indexOf(status) >= 0).join(','))
+      }
+      const hash = params.toString()
+      return (hash === '') ? '' : `#${hash}`
+    },
+
+    /**
+     * Decodes a URL hash written by formatFilterHash.
+     * @param {string} hash
+     * @returns {TestFilter}
+     */
+    parseFilterHash: function (hash) {
+      const params = new URLSearchParams(hash.replace(/^#/, ''))
+      return {
+        query: params.get('q') || '',
+        statuses: params.has('status')
+          ? params.get('status').split(',').filter((status) => testStatusFilters.indexOf(status) >= 0)
+          : testStatusFilters.slice()
+      }
+    }
+  }
+
+  /**
+   * Renders the subtest trees of the given test groups in the test list, applying the current filter. The package
+   * name precedes the tests of each group when several groups are rendered.
+   * @param {TestResults} data
+   * @param {Array.<string>} testGroupIds
+   */
+  function renderTestGroupList(data, testGroupIds) {
+    const testGroupListElem = elements.testGroupListElem
+    testGroupListElem.textContent = ''
+    const active = isFilterActive()
+    testGroupIds.forEach((testGroupId) => {
+      const testResults = data[testGroupId]['TestResults']
+      const tree = active
+        ? goTestReport.buildTestTree(testResults, (testStatus) => goTestReport.matchesFilter(testStatus, filterState))
+        : goTestReport.buildTestTree(testResults)
+      if (tree.length === 0) {
+        return
+      }
+      if (testGroupIds.length > 1) {
+        const headerElem = document.createElement('div')
+        headerElem.classList.add('testGroupHeader')
+        headerElem.textContent = data[testGroupId]['PackageName']
+        testGroupListElem.appendChild(headerElem)
+      }
+      tree.forEach((node) => testGroupListElem.appendChild(createTestTreeElement(node, testGroupId, active)))
+    })
+  }
+
+  /**
+   * @returns {boolean} True unless the current filter shows all tests.
+   */
+  function isFilterActive() {
+    return goTestReport.formatFilterHash(filterState) !== ''
+  }
+
+  /**
+   * Synchronizes the search box and status checkboxes with the current filter.
+   */
+  function updateFilterInputs() {
+    if (elements.searchInputElem != null && elements.searchInputElem.value !== filterState.query) {
+      elements.searchInputElem.value = filterState.query
+    }
+    if (elements.statusFilterElems != null) {
+      elements.statusFilterElems.forEach((elem) =>
+        elem.checked = filterState.statuses.indexOf(elem.getAttribute('data-status')) >= 0)
+    }
+  }
+
+  /**
+   * Reads the filter from the search box and status checkboxes.
+   * @returns {TestFilter}
+   */
+  function readFilterInputs() {
+    const statuses = []
+    elements.statusFilterElems.forEach((elem) => {
+      if (elem.checked) {
+        statuses.push(elem.getAttribute('data-status'))
+      }
+    })
+    return {query: elements.searchInputElem.value, statuses: statuses}
+  }
+
+  /**
+   * @param {TestStatus} testStatus
+   * @returns {string} One of testStatusFilters; interrupted tests are failed tests.
+   */
+  function testStatusName(testStatus) {
+    return testStatus.Passed ? 'passed' : (testStatus.Skipped ? 'skipped' : 'failed')
+  }
+
+  /**
+   * Returns the lower case text searched by the search box: the test name, gunit title, package and output.
+   * @param {TestStatus} testStatus
+   * @returns {string}
+   */
+  function testSearchText(testStatus) {
+    let text = searchTextCache.get(testStatus)
+    if (text === undefined) {
+      text = [testStatus.TestName, testStatus.Title || '', testStatus.Package, (testStatus.Output || []).join('')]
+        .join('\n')
+        .replace(ansiEscapeRegex, '')
+        .toLowerCase()
+      searchTextCache.set(testStatus, text)
+    }
+    return text
+  }
+
+  /**
+   * Creates the side by side view of the expected and actual values of an assertion, the differences being
+   * highlighted.
+   * @param {AssertionDiff} assertion
+   * @returns {HTMLDivElement}
+   */
+  function createAssertionDiffElement(assertion) {
+    const diff = goTestReport.diffAssertion(assertion.Expected, assertion.Actual)
+    const assertionDiv = document.createElement('div')
+    assertionDiv.classList.add('assertionDiff')
+    const createValueElement = (className, label, tokens, changedTagName) => {
+      const valueDiv = document.createElement('div')
+      valueDiv.classList.add(className)
+      const labelElem = document.createElement('span')
+      labelElem.classList.add('label')
+      labelElem.textContent = label
+      const valuePre = document.createElement('pre')
+      tokens.forEach((token) => {
+        if (token.changed) {
+          const changedElem = document.createElement(changedTagName)
+          changedElem.textContent = token.text
+          valuePre.appendChild(changedElem)
+        } else {
+          valuePre.append(token.text)
+        }
+      })
+      valueDiv.append(labelElem, valuePre)
+      return valueDiv
+    }
+    assertionDiv.append(createValueElement('expected', 'Expected', diff.expected, 'del'),
+                        createValueElement('actual', 'Actual', diff.actual, 'ins'))
+    return assertionDiv
+  }
+
+  /**
+   * Creates the bold label of a test detail, e.g. "Package:".
+   * @param {string} label
+   * @returns {HTMLElement}
+   */
+  function createLabelElement(label) {
+    const labelElem = document.createElement('strong')
+    labelElem.textContent = label
+    return labelElem
+  }
+
+  /**
+   * Appends the output of a test to an element, its ANSI colors being turned into styled spans. The text is never
+   * parsed as HTML.
+   * @param {HTMLElement} elem
+   * @param {string} text
+   */
+  function appendAnsiOutput(elem, text) {
+    if (text.indexOf('\u001b') < 0) {
+      elem.append(text)
+      return
+    }
+    goTestReport.parseAnsiOutput(text).forEach((segment) => {
+      if (segment.classes.length === 0 && segment.color == null && segment.backgroundColor == null) {
+        elem.append(segment.text)
+        return
+      }
+      const span = document.createElement('span')
+      segment.classes.forEach((className) => span.classList.add(className))
+      if (segment.color != null) {
+        span.style.color = segment.color
+      }
+      if (segment.backgroundColor != null) {
+        span.style.backgroundColor = segment.backgroundColor
+      }
+      span.textContent = segment.text
+      elem.appendChild(span)
+    })
+  }
+
+  /**
+   * Applies the parameters of an SGR escape sequence, e.g. "1;31", to a text style.
+   * @param {{classes: Array.<string>, color: ?string, backgroundColor: ?string}} style
+   * @param {string} parameters
+   * @returns {{classes: Array.<string>, color: ?string, backgroundColor: ?string}} The new style.
+   */
+  function applySgrCodes(style, parameters) {
+    const codes = (parameters === '') ? [0] : parameters.split(';').map((code) => parseInt(code, 10) || 0)
+    const result = {classes: style.classes.slice(), color: style.color, backgroundColor: style.backgroundColor}
+    const setClass = (prefix, className) => {
+      result.classes = result.classes.filter((existing) => !existing.startsWith(prefix))
+      if (className != null) {
+        result.classes.push(className)
+      }
+    }
+    const setColor = (background, colorIndex, rgb) => {
+      setClass(background ? 'ansi-bg-' : 'ansi-fg-', (colorIndex != null) ? `ansi-${background ? 'bg' : 'fg'}-${colorIndex}` : null)
+      if (background) {
+        result.backgroundColor = rgb
+      } else {
+        result.color = rgb
+      }
+    }
+    for (let i = 0; i < codes.length; i++) {
+      const code = codes[i]
+      if (code === 0) {
+        result.classes = []
+        result.color = null
+        result.backgroundColor = null
+      } else if (code === 1) {
+        setClass('ansi-bold', 'ansi-bold')
+      } else if (code === 2) {
+        setClass('ansi-faint', 'ansi-faint')
+      } else if (code === 3) {
+        setClass('ansi-italic', 'ansi-italic')
+      } else if (code === 4) {
+        setClass('ansi-underline', 'ansi-underline')
+      } else if (code === 22) {
+        setClass('ansi-bold', null)
+        setClass('ansi-faint', null)
+      } else if (code === 23) {
+        setClass('ansi-italic', null)
+      } else if (code === 24) {
+        setClass('ansi-underline', null)
+      } else if ((code >= 30 && code <= 37) || (code >= 40 && code <= 47)) {
+        setColor(code >= 40, code % 10, null)
+      } else if ((code >= 90 && code <= 97) || (code >= 100 && code <= 107)) {
+        setColor(code >= 100, code % 10 + 8, null)
+      } else if (code === 39 || code === 49) {
+        setColor(code === 49, null, null)
+      } else if ((code === 38 || code === 48) && codes[i + 1] === 5 && i + 2 < codes.length) {
+        const colorIndex = codes[i + 2]
+        setColor(code === 48, (colorIndex < 16) ? colorIndex : null, (colorIndex < 16) ? null : ansi256Color(colorIndex))
+        i += 2
+      } else if ((code === 38 || code === 48) && codes[i + 1] === 2 && i + 4 < codes.length) {
+        const rgb = codes.slice(i + 2, i + 5).map((value) => Math.min(Math.max(value, 0), 255))
+        setColor(code === 48, null, `rgb(${rgb.join(',')})`)
+        i += 4
+      }
+    }
+    return result
+  }
+
+  /**
+   * Returns the color of an index, from 16 to 255, of the 256 color palette of terminals.
+   * @param {number} colorIndex
+   * @returns {string}
+   */
+  function ansi256Color(colorIndex) {
+    if (colorIndex >= 232) {
+      const level = Math.min(8 + (colorIndex - 232) * 10, 255)
+      return `rgb(${level},${level},${level})`
+    }
+    const cubeIndex = colorIndex - 16
+    return `rgb(${ansiColorLevels[Math.floor(cubeIndex / 36)]},${ansiColorLevels[Math.floor(cubeIndex / 6) % 6]},${ansiColorLevels[cubeIndex % 6]})`
+  }
+
+  /**
+   * Creates the card of an HTTP call: its method, URL, status and latency, then the headers and bodies of the request
+   * and of the response.
+   * @param {ApiRequest} request
+   * @returns {HTMLDivElement}
+   */
+  function createRequestCardElement(request) {
+    const cardDiv = document.createElement('div')
+    cardDiv.classList.add('requestCard')
+    const summaryDiv = document.createElement('div')
+    summaryDiv.classList.add('requestSummary')
+    const addSummaryItem = (className, text) => {
+      const itemElem = document.createElement('span')
+      itemElem.classList.add(className)
+      itemElem.textContent = text
+      summaryDiv.appendChild(itemElem)
+      return itemElem
+    }
+    addSummaryItem('method', request.Method || 'GET')
+    addSummaryItem('url', request.URL || request.Name || '')
+    if (request.Status) {
+      const statusElem = addSummaryItem('statusCode', request.Status)
+      const statusCode = parseInt(request.Status, 10)
+      if (statusCode >= 500) {
+        statusElem.classList.add('serverError')
+      } else if (statusCode >= 400) {
+        statusElem.classList.add('clientError')
+      } else if (statusCode >= 200 && statusCode < 400) {
+        statusElem.classList.add('success')
+      }
+    }
+    if (request.Latency) {
+      addSummaryItem('latency', request.Latency)
+    }
+    if (request.Name && request.Name !== request.URL) {
+      addSummaryItem('apiName', request.Name)
+    }
+    const copyButton = document.createElement('button')
+    copyButton.classList.add('copyCurl')
+    copyButton.textContent = 'Copy as curl'
+    copyButton.addEventListener('click', () => copyText(goTestReport.formatCurlCommand(request), copyButton))
+    summaryDiv.appendChild(copyButton)
+
+    const createSide = (className, title, headers, body) => {
+      const sideDiv = document.createElement('div')
+      sideDiv.classList.add(className)
+      const titleElem = document.createElement('div')
+      titleElem.classList.add('sideTitle')
+      titleElem.textContent = title
+      sideDiv.appendChild(titleElem)
+      const headerNames = Object.keys(headers || {}).sort()
+      if (headerNames.length > 0) {
+        const headersTable = document.createElement('table')
+        headersTable.classList.add('headers')
+        headerNames.forEach((name) => {
+          const row = document.createElement('tr')
+          const nameElem = document.createElement('td')
+          nameElem.textContent = name
+          const valueElem = document.createElement('td')
+          valueElem.textContent = headers[name]
+          row.append(nameElem, valueElem)
+          headersTable.appendChild(row)
+        })
+        sideDiv.appendChild(headersTable)
+      }
+      if (body) {
+        const bodyPre = document.createElement('pre')
+        bodyPre.classList.add('body')
+        bodyPre.textContent = formatBody(body)
+        sideDiv.appendChild(bodyPre)
+      }
+      return sideDiv
     }
+    const detailsDiv = document.createElement('div')
+    detailsDiv.classList.add('requestDetails')
+    detailsDiv.append(createSide('request', 'Request', request.Headers, request.Body),
+                      createSide('response', 'Response', request.ResponseHeaders, request.ResponseBody))
+    cardDiv.append(summaryDiv, detailsDiv)
+    return cardDiv
+  }
+
+  /**
+   * Pretty-prints a body if it is JSON.
+   * @param {string} body
+   * @returns {string}
+   */
+  function formatBody(body) {
+    try {
+      const value = JSON.parse(body)
+      if (value !== null && typeof value === 'object') {
+        return JSON.stringify(value, null, 2)
+      }
+    } catch (e) {
+      // not JSON, shown as is
+    }
+    return body
+  }
+
+  /**
+   * Copies a text to the clipboard, confirming it in the label of the button.
+   * @param {string} text
+   * @param {HTMLElement} button
+   */
+  function copyText(text, button) {
+    const label = button.textContent
+    const copied = () => {
+      button.textContent = 'Copied'
+      setTimeout(() => button.textContent = label, 1500)
+    }
+    if (window.navigator.clipboard != null) {
+      window.navigator.clipboard.writeText(text).then(copied)
+      return
+    }
+    // without the asynchronous clipboard API, e.g. when the report is opened from a file in some browsers
+    const textArea = document.createElement('textarea')
+    textArea.value = text
+    document.body.appendChild(textArea)
+    textArea.select()
+    document.execCommand('copy')
+    textArea.remove()
+    copied()
+  }
+
+  /**
+   * Creates the table of the gunit log records of a test, along with checkboxes filtering them by level. The fields
+   * of a record are shown when its row is expanded.
+   * @param {Array.<LogEntry>} logEntries
+   * @returns {HTMLDivElement}
+   */
+  function createLogEntriesElement(logEntries) {
+    const logEntriesDiv = document.createElement('div')
+    logEntriesDiv.classList.add('logEntries')
+    const levelFilterDiv = document.createElement('div')
+    levelFilterDiv.classList.add('logLevelFilter')
+    const table = document.createElement('table')
+    table.classList.add('logTable')
+    const headerRow = document.createElement('tr')
+    headerRow.append(...['Time', 'Level', 'Message'].map((header) => {
+      const headerElem = document.createElement('th')
+      headerElem.textContent = header
+      return headerElem
+    }))
+    table.appendChild(headerRow)
+
+    const rowsByLevel = new Map(logLevels.map((level) => [level, []]))
+    logEntries.forEach((logEntry) => {
+      const level = normalizeLogLevel(logEntry.Level)
+      const row = document.createElement('tr')
+      row.classList.add('logEntry')
+      const timeElem = document.createElement('td')
+      timeElem.classList.add('logTime')
+      const time = /T(\d{2}:\d{2}:\d{2}(?:\.\d{1,3})?)/.exec(logEntry.Time || '')
+      timeElem.textContent = (time != null) ? time[1] : (logEntry.Time || '')
+      timeElem.setAttribute('title', logEntry.Time || '')
+      const levelElem = document.createElement('td')
+      levelElem.classList.add('logLevel')
+      levelElem.textContent = logEntry.Level || ''
+      const messageElem = document.createElement('td')
+      messageElem.classList.add('logMessage')
+      messageElem.textContent = logEntry.Message || ''
+      row.append(timeElem, levelElem, messageElem)
+      table.appendChild(row)
+      const levelRows = [row]
+
+      const fieldNames = Object.keys(logEntry.Fields || {})
+      if (fieldNames.length > 0) {
+        const fieldsRow = document.createElement('tr')
+        fieldsRow.classList.add('logFields', 'collapsed')
+        const fieldsCell = document.createElement('td')
+        fieldsCell.setAttribute('colspan', '3')
+        const fieldsPre = document.createElement('pre')
+        fieldsPre.textContent = JSON.stringify(logEntry.Fields, null, 2)
+        fieldsCell.appendChild(fieldsPre)
+        fieldsRow.appendChild(fieldsCell)
+        table.appendChild(fieldsRow)
+        levelRows.push(fieldsRow)
+
+        const fieldsToggle = document.createElement('button')
+        fieldsToggle.classList.add('fieldsToggle')
+        fieldsToggle.textContent = `\u25b8 ${fieldNames.length} field(s)`
+        fieldsToggle.addEventListener('click', () => {
+          const collapsed = fieldsRow.classList.toggle('collapsed')
+          fieldsToggle.textContent = `${collapsed ? '\u25b8' : '\u25be'} ${fieldNames.length} field(s)`
+        })
+        messageElem.append(' ', fieldsToggle)
+      }
+      if (level !== '') {
+        row.classList.add(level)
+        rowsByLevel.set(level, rowsByLevel.get(level).concat(levelRows))
+      }
+    })
+
+    logLevels.forEach((level) => {
+      const count = logEntries.filter((logEntry) => normalizeLogLevel(logEntry.Level) === level).length
+      const label = document.createElement('label')
+      const checkbox = document.createElement('input')
+      checkbox.setAttribute('type', 'checkbox')
+      checkbox.setAttribute('data-level', level)
+      checkbox.checked = true
+      checkbox.addEventListener('change', () =>
+        rowsByLevel.get(level).forEach((row) => row.classList.toggle('filteredOut', !checkbox.checked)))
+      label.append(checkbox, ` ${level} (${count})`)
+      levelFilterDiv.appendChild(label)
+    })
+    logEntriesDiv.append(levelFilterDiv, table)
+    return logEntriesDiv
+  }
+
+  /**
+   * Maps the level of a log record to one of the levels of the filter, or "" if it is unknown, in which case the
+   * record is never filtered out.
+   * @param {string} level
+   * @returns {string}
+   */
+  function normalizeLogLevel(level) {
+    const name = (level || '').toLowerCase()
+    if (name === 'trace' || name === 'debug') {
+      return 'debug'
+    } else if (name === 'info') {
+      return 'info'
+    } else if (name === 'warn' || name === 'warning') {
+      return 'warn'
+    } else if (['error', 'err', 'dpanic', 'panic', 'fatal'].indexOf(name) >= 0) {
+      return 'error'
+    }
+    return ''
+  }
+
+  /**
+   * Creates the button showing or hiding the raw output of a test, which is initially hidden.
+   * @param {HTMLElement} consolePre
+   * @returns {HTMLButtonElement}
+   */
+  function createRawOutputToggle(consolePre) {
+    const toggleButton = document.createElement('button')
+    toggleButton.classList.add('rawOutputToggle')
+    toggleButton.textContent = 'Show raw output'
+    consolePre.classList.add('hidden')
+    toggleButton.addEventListener('click', () => {
+      const hidden = consolePre.classList.toggle('hidden')
+      toggleButton.textContent = hidden ? 'Show raw output' : 'Hide raw output'
+    })
+    return toggleButton
+  }
+
+  /**
+   * Creates the history details of a test: a sparkline of its durations over the last runs, where failed runs are
+   * marked red, and when it started to fail.
+   * @param {TestHistory} history
+   * @returns {HTMLDivElement}
+   */
+  function createTestHistoryElement(history) {
+    const historyDiv = document.createElement('div')
+    historyDiv.classList.add('history')
+    historyDiv.appendChild(createLabelElement('Duration trend:'))
+
+    const width = 120
+    const height = 20
+    const durations = history.Durations.filter((duration) => duration != null)
+    const maxDuration = Math.max(...durations, 0)
+    const step = (history.Durations.length > 1) ? width / (history.Durations.length - 1) : 0
+    const svgNamespace = 'http://www.w3.org/2000/svg'
+    const svg = document.createElementNS(svgNamespace, 'svg')
+    svg.setAttribute('width', width.toString())
+    svg.setAttribute('height', height.toString())
+    const points = []
+    history.Durations.forEach((duration, i) => {
+      if (duration == null) {
+        return
+      }
+      const x = (i * step).toFixed(1)
+      const y = ((maxDuration > 0) ? height - 2 - (duration / maxDuration) * (height - 4) : height / 2).toFixed(1)
+      points.push(`${x},${y}`)
+      if (history.Statuses[i] === 'failed') {
+        const circle = document.createElementNS(svgNamespace, 'circle')
+        circle.setAttribute('class', 'failed')
+        circle.setAttribute('cx', x)
+        circle.setAttribute('cy', y)
+        circle.setAttribute('r', '2')
+        svg.appendChild(circle)
+      }
+    })
+    const polyline = document.createElementNS(svgNamespace, 'polyline')
+    polyline.setAttribute('points', points.join(' '))
+    svg.insertBefore(polyline, svg.firstChild)
+    historyDiv.appendChild(svg)
+    historyDiv.append(`${durations.length} of ${history.Durations.length} run(s), max ${maxDuration}s`)
+
+    if (history.FirstFailedAt) {
+      const firstFailedDiv = document.createElement('div')
+      firstFailedDiv.classList.add('firstFailed')
+      firstFailedDiv.append(createLabelElement('First failed at:'), ` ${history.FirstFailedAt}`)
+      if (history.FirstFailedCommit) {
+        firstFailedDiv.append(` (${history.FirstFailedCommit})`)
+      }
+      historyDiv.appendChild(firstFailedDiv)
+    }
+    return historyDiv
+  }
+
+  /**
+   * Removes the tests that are not included and have no included subtests.
+   * @param {Array.<TestTreeNode>} nodes
+   * @param {function(TestStatus): boolean} include
+   * @returns {Array.<TestTreeNode>}
+   */
+  function pruneTestTree(nodes, include) {
+    return nodes.filter((node) => {
+      node.children = pruneTestTree(node.children, include)
+      return node.children.length > 0 || (node.testStatus != null && include(node.testStatus))
+    })
+  }
+
+  /**
+   * Returns the test name without the gunit title, e.g. "TestFoo/Case1" for "TestFoo/Case1(title)".
+   * @param {TestStatus} testStatus
+   * @returns {string}
+   */
+  function testBaseName(testStatus) {
+    const suffix = `(${testStatus.Title})`
+    if (testStatus.Title && testStatus.TestName.endsWith(suffix)) {
+      return testStatus.TestName.substring(0, testStatus.TestName.length - suffix.length)
+    }
+    return testStatus.TestName
+  }
+
+  /**
+   * Computes the pass/fail/skip counts and the duration of a tree node from its own result and its subtests.
+   * Omitted parents are only counted through their subtests, as in the header of the report.
+   * @param {TestTreeNode} node
+   */
+  function aggregateTestTreeNode(node) {
+    node.children.forEach(aggregateTestTreeNode)
+    const testStatus = node.testStatus
+    if (testStatus != null && testStatus.Omitted !== true) {
+      if (testStatus.Passed) {
+        node.passed += 1
+      } else if (testStatus.Skipped) {
+        node.skipped += 1
+      } else {
+        node.failed += 1
+      }
+    }
+    let childrenElapsedTime = 0
+    node.children.forEach((child) => {
+      node.passed += child.passed
+      node.failed += child.failed
+      node.skipped += child.skipped
+      childrenElapsedTime += child.elapsedTime
+    })
+    // the duration of a parent test already includes the duration of its subtests
+    node.elapsedTime = (testStatus != null) ? testStatus.ElapsedTime : childrenElapsedTime
+  }
+
+  /**
+   * Returns the status CSS classes of a tree node; a node without a result of its own gets the worst status of
+   * its subtests.
+   * @param {TestTreeNode} node
+   * @returns {string}
+   */
+  function testTreeNodeStatus(node) {
+    const testStatus = node.testStatus
+    if (testStatus == null) {
+      return (node.failed > 0) ? 'failed' : ((node.passed === 0 && node.skipped > 0) ? 'skipped' : '')
+    }
+    if (testStatus.Passed) {
+      return ''
+    }
+    if (testStatus.Skipped) {
+      return 'skipped'
+    }
+    return (testStatus.Interrupted === true) ? 'failed interrupted' : 'failed'
+  }
+
+  /**
+   * Creates the element of a tree node: its test row, followed by the collapsible list of its subtests. Subtrees
+   * without failures are collapsed initially, unless expanded is set.
+   * @param {TestTreeNode} node
+   * @param {string} testId The id of the test group.
+   * @param {boolean} expanded
+   * @returns {HTMLDivElement}
+   */
+  function createTestTreeElement(node, testId, expanded) {
+    const status = testTreeNodeStatus(node)
+    const nodeElem = document.createElement('div')
+    nodeElem.classList.add('testTreeNode')
+    const rowElem = document.createElement('div')
+    rowElem.className = `testGroupRow ${status}`.trim()
+    if (node.index >= 0) {
+      rowElem.setAttribute('data-groupid', testId)
+      rowElem.setAttribute('data-index', node.index.toString())
+    }
+    const statusElem = document.createElement('span')
+    statusElem.className = `testStatus ${status}`.trim()
+    statusElem.textContent = (status === '') ? '\u2713' : ((status === 'skipped') ? '\u2010' : ((status === 'failed') ? '\u2717' : '\u26a0'))
+    const toggleElem = document.createElement('span')
+    toggleElem.classList.add('treeToggle')
+    const titleElem = document.createElement('span')
+    titleElem.classList.add('testTitle')
+    titleElem.textContent = (node.testStatus != null && node.testStatus.Title) ? `${node.name}(${node.testStatus.Title})` : node.name
+    if (node.testStatus != null && node.testStatus.Flaky === true) {
+      const flakyElem = document.createElement('span')
+      flakyElem.classList.add('flakyBadge')
+      flakyElem.textContent = 'flaky'
+      titleElem.appendChild(flakyElem)
+    }
+    const durationElem = document.createElement('span')
+    durationElem.classList.add('testDuration')
+    const elapsedTimeElem = document.createElement('span')
+    elapsedTimeElem.textContent = `${node.elapsedTime}s `
+    durationElem.append(elapsedTimeElem, '\u23f1')
+    rowElem.append(statusElem, toggleElem, titleElem, durationElem)
+    nodeElem.appendChild(rowElem)
+
+    if (node.children.length > 0) {
+      const countsElem = document.createElement('span')
+      countsElem.classList.add('testCounts')
+      countsElem.textContent = `\u2713${node.passed} \u2717${node.failed} \u2010${node.skipped} `
+      durationElem.insertAdjacentElement('afterbegin', countsElem)
+      const childrenElem = document.createElement('div')
+      childrenElem.classList.add('testTreeChildren')
+      if (node.failed === 0 && !expanded) {
+        childrenElem.classList.add('collapsed')
+      }
+      toggleElem.textContent = childrenElem.classList.contains('collapsed') ? '\u25b8' : '\u25be'
+      node.children.forEach((child) => childrenElem.appendChild(createTestTreeElement(child, testId, expanded)))
+      nodeElem.appendChild(childrenElem)
+    }
+    return nodeElem
   }
 
   //+------------------------+
@@ -186,9 +1246,25 @@ window.GoTestReport = function (elements) {
                                                  goTestReport.testGroupListHandler))
 
   elements.testGroupListElem
-          .addEventListener('click', event =>
-            goTestReport.testGroupListHandler(/**@type {Element}*/ event.target,
-                                              elements.data))
+          .addEventListener('click', event => {
+            if (event.target.classList.contains('treeToggle')) {
+              goTestReport.treeToggleHandler(/**@type {Element}*/ event.target)
+            } else {
+              goTestReport.testGroupListHandler(/**@type {Element}*/ event.target,
+                                                elements.data)
+            }
+          })
+
+  if (elements.searchInputElem != null && elements.statusFilterElems != null) {
+    const filterChanged = () => goTestReport.applyFilter(readFilterInputs())
+    elements.searchInputElem.addEventListener('input', filterChanged)
+    elements.statusFilterElems.forEach((elem) => elem.addEventListener('change', filterChanged))
+  }
+  window.addEventListener('hashchange', () =>
+    goTestReport.applyFilter(goTestReport.parseFilterHash(window.location.hash)))
+  if (window.location.hash !== '') {
+    goTestReport.applyFilter(goTestReport.parseFilterHash(window.location.hash))
+  }
 
   return goTestReport
 }
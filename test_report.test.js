@@ -162,4 +162,385 @@ test('test testGroupListHandler using [test group: 1]', () => {
   expect(packageElem.innerHTML).toBe(`<strong>Package:</strong> test/package 4`)
   const filenameElem = testDetailElem.querySelector('.filename')
   expect(filenameElem.innerHTML).toBe(`<strong>Filename:</strong> test_test_3.go &nbsp;&nbsp;<strong>Line:</strong> 101 <strong>Col:</strong> 9`)
-})
\ No newline at end of file
+})
+const mockSubtestResults = [
+  {TestName: "TestParent", Package: "test/package", ElapsedTime: 0.3, Omitted: true, Output: []},
+  {TestName: "TestParent/Case_1", Package: "test/package", ElapsedTime: 0.1, Passed: true, Output: []},
+  {TestName: "TestParent/Case_2", Package: "test/package", ElapsedTime: 0.2, Omitted: true, Output: []},
+  {TestName: "TestParent/Case_2/Nested_1", Package: "test/package", ElapsedTime: 0.1, Output: []},
+  {TestName: "TestParent/Case_2/Nested_2", Package: "test/package", ElapsedTime: 0.1, Skipped: true, Output: []},
+  {TestName: "TestOther(with a title)", Title: "with a title", Package: "test/package", ElapsedTime: 0.5, Passed: true, Omitted: true, Output: []},
+  {TestName: "TestOther/Case(with a title)", Title: "with a title", Package: "test/package", ElapsedTime: 0.4, Passed: true, Output: []},
+]
+
+test('test buildTestTree', () => {
+  const goTestReport = window.GoTestReport(createTestElements())
+  const tree = goTestReport.buildTestTree(mockSubtestResults)
+  expect(tree.map((node) => node.name)).toEqual(['TestParent', 'TestOther'])
+
+  const parent = tree[0]
+  expect(parent.index).toBe(0)
+  expect(parent.children.map((node) => node.name)).toEqual(['Case_1', 'Case_2'])
+  expect([parent.passed, parent.failed, parent.skipped]).toEqual([1, 1, 1])
+  expect(parent.elapsedTime).toBe(0.3)
+
+  const case2 = parent.children[1]
+  expect(case2.index).toBe(2)
+  expect(case2.children.map((node) => node.index)).toEqual([3, 4])
+  expect([case2.passed, case2.failed, case2.skipped]).toEqual([0, 1, 1])
+
+  const other = tree[1]
+  expect(other.children.map((node) => node.name)).toEqual(['Case'])
+  expect([other.passed, other.failed, other.skipped]).toEqual([1, 0, 0])
+})
+
+test('test buildTestTree with a subtest reported without its parent', () => {
+  const goTestReport = window.GoTestReport(createTestElements())
+  const tree = goTestReport.buildTestTree([
+    {TestName: "TestParent/Case_1", ElapsedTime: 0.25, Passed: true, Output: []},
+    {TestName: "TestParent/Case_2", ElapsedTime: 0.5, Passed: true, Output: []},
+  ])
+  expect(tree).toHaveLength(1)
+  expect(tree[0].index).toBe(-1)
+  expect(tree[0].testStatus).toBeNull()
+  expect(tree[0].passed).toBe(2)
+  expect(tree[0].elapsedTime).toBe(0.75)
+})
+
+test('test testResultsClickHandler renders the subtest tree', () => {
+  const testElements = createTestElements()
+  testElements.data = [{TestResults: mockSubtestResults}]
+  const goTestReport = window.GoTestReport(testElements)
+  const testResultGroup = testElements.testResultsElem.querySelector('#\\30')
+  testResultGroup.classList.add('testResultGroup')
+  goTestReport.testResultsClickHandler(testResultGroup,
+                                       false,
+                                       testElements.data,
+                                       {testResults: null, selectedTestGroupColor: null},
+                                       goTestReport.testGroupListHandler)
+
+  const topLevelNodes = testElements.testGroupListElem.querySelectorAll(':scope > .testTreeNode')
+  expect(topLevelNodes).toHaveLength(2)
+  const parentRow = topLevelNodes[0].querySelector(':scope > .testGroupRow')
+  expect(parentRow.classList.contains('failed')).toBe(true)
+  expect(parentRow.getAttribute('data-index')).toBe('0')
+  expect(parentRow.querySelector('.testTitle').textContent).toBe('TestParent')
+  expect(parentRow.querySelector('.testCounts').textContent).toBe('✓1 ✗1 ‐1 ')
+
+  // subtrees with failures are expanded, the others are collapsed
+  const parentChildren = topLevelNodes[0].querySelector(':scope > .testTreeChildren')
+  expect(parentChildren.classList.contains('collapsed')).toBe(false)
+  expect(parentChildren.querySelectorAll('.testGroupRow')).toHaveLength(4)
+  const otherChildren = topLevelNodes[1].querySelector(':scope > .testTreeChildren')
+  expect(otherChildren.classList.contains('collapsed')).toBe(true)
+  expect(otherChildren.querySelector('.testTitle').textContent).toBe('Case(with a title)')
+
+  const toggle = topLevelNodes[1].querySelector('.treeToggle')
+  goTestReport.treeToggleHandler(toggle)
+  expect(otherChildren.classList.contains('collapsed')).toBe(false)
+  goTestReport.treeToggleHandler(toggle)
+  expect(otherChildren.classList.contains('collapsed')).toBe(true)
+})
+
+/**
+ * Creates the test elements of createTestElements with a search box and status checkboxes.
+ * @param {TestResults} data
+ * @returns {GoTestReportElements}
+ */
+function createFilterTestElements(data) {
+  const testElements = createTestElements()
+  testElements.data = data
+  testElements.testResultsElem.querySelectorAll('div').forEach((elem) => elem.classList.add('testResultGroup'))
+  testElements.searchInputElem = document.createElement('input')
+  const statusFilterElems = ['passed', 'failed', 'skipped'].map((status) => {
+    const checkbox = document.createElement('input')
+    checkbox.type = 'checkbox'
+    checkbox.checked = true
+    checkbox.setAttribute('data-status', status)
+    return checkbox
+  })
+  testElements.statusFilterElems = statusFilterElems
+  return testElements
+}
+
+const mockFilterData = [
+  {PackageName: "test/package 1", TestResults: mockData[0].TestResults},
+  {PackageName: "test/package 2", TestResults: mockData[1].TestResults},
+  {PackageName: "test/package 3", TestResults: mockSubtestResults},
+]
+
+test('test matchesFilter', () => {
+  const goTestReport = window.GoTestReport(createTestElements())
+  const allStatuses = ['passed', 'failed', 'skipped']
+  const testStatus = mockSubtestResults[6]
+  expect(goTestReport.matchesFilter(testStatus, {query: '', statuses: allStatuses})).toBe(true)
+  expect(goTestReport.matchesFilter(testStatus, {query: 'testother/CASE', statuses: allStatuses})).toBe(true)
+  expect(goTestReport.matchesFilter(testStatus, {query: 'With A Title', statuses: allStatuses})).toBe(true)
+  expect(goTestReport.matchesFilter(testStatus, {query: 'test/package', statuses: allStatuses})).toBe(true)
+  expect(goTestReport.matchesFilter(testStatus, {query: 'TestParent', statuses: allStatuses})).toBe(false)
+  expect(goTestReport.matchesFilter(testStatus, {query: '', statuses: ['failed', 'skipped']})).toBe(false)
+  expect(goTestReport.matchesFilter(mockData[0].TestResults[0], {query: 'output A 2', statuses: ['failed']})).toBe(true)
+  expect(goTestReport.matchesFilter(mockSubtestResults[4], {query: '', statuses: ['skipped']})).toBe(true)
+})
+
+test('test formatFilterHash and parseFilterHash', () => {
+  const goTestReport = window.GoTestReport(createTestElements())
+  const allStatuses = ['passed', 'failed', 'skipped']
+  expect(goTestReport.formatFilterHash({query: '', statuses: allStatuses})).toBe('')
+  expect(goTestReport.formatFilterHash({query: 'a b&c', statuses: ['skipped', 'failed']})).toBe('#q=a+b%26c&status=failed%2Cskipped')
+  expect(goTestReport.parseFilterHash('')).toEqual({query: '', statuses: allStatuses})
+  expect(goTestReport.parseFilterHash('#q=a+b%26c&status=failed%2Cskipped')).toEqual({query: 'a b&c', statuses: ['failed', 'skipped']})
+  expect(goTestReport.parseFilterHash('#status=unknown')).toEqual({query: '', statuses: []})
+})
+
+test('test applyFilter', () => {
+  const testElements = createFilterTestElements(mockFilterData)
+  const goTestReport = window.GoTestReport(testElements)
+  goTestReport.applyFilter({query: '', statuses: ['failed']})
+
+  const tiles = testElements.testResultsElem.querySelectorAll('.testResultGroup')
+  expect(Array.from(tiles).map((elem) => elem.classList.contains('filteredOut'))).toEqual([false, false, false])
+  const headers = testElements.testGroupListElem.querySelectorAll('.testGroupHeader')
+  expect(Array.from(headers).map((elem) => elem.textContent)).toEqual(['test/package 1', 'test/package 2', 'test/package 3'])
+  const titles = testElements.testGroupListElem.querySelectorAll('.testTitle')
+  expect(Array.from(titles).map((elem) => elem.textContent)).toEqual(['my_sample_test 1', 'my_sample_test 3', 'TestParent', 'Case_2', 'Nested_1'])
+  expect(window.location.hash).toBe('#status=failed')
+  expect(testElements.statusFilterElems.map((elem) => elem.checked)).toEqual([false, true, false])
+
+  testElements.searchInputElem.value = 'nested'
+  testElements.statusFilterElems.forEach((elem) => elem.checked = true)
+  testElements.searchInputElem.dispatchEvent(new Event('input'))
+  expect(Array.from(tiles).map((elem) => elem.classList.contains('filteredOut'))).toEqual([true, true, false])
+  const rows = testElements.testGroupListElem.querySelectorAll('.testGroupRow')
+  expect(Array.from(rows).map((elem) => elem.getAttribute('data-index'))).toEqual(['0', '2', '3', '4'])
+  expect(window.location.hash).toBe('#q=nested')
+
+  goTestReport.applyFilter({query: '', statuses: ['passed', 'failed', 'skipped']})
+  expect(Array.from(tiles).map((elem) => elem.classList.contains('filteredOut'))).toEqual([false, false, false])
+  expect(testElements.testGroupListElem.innerHTML).toBe('')
+  expect(window.location.hash).toBe('')
+})
+
+test('test testGroupListHandler with the history of a test', () => {
+  const testStatus = Object.assign({}, mockData[0].TestResults[0], {
+    History: {
+      Durations: [0.5, null, 1, 2],
+      Statuses: ['passed', '', 'failed', 'failed'],
+      FirstFailedAt: 'July 10, 2020 01:24:45',
+      FirstFailedCommit: '1a2b3c4',
+    }
+  })
+  const goTestReport = window.GoTestReport(createTestElements())
+  const divElem = createDataGroupElement(0, 0)
+  goTestReport.testGroupListHandler(divElem, [{TestResults: [testStatus]}])
+  const historyElem = divElem.querySelector('.testDetail .history')
+  expect(historyElem.querySelector('polyline').getAttribute('points')).toBe('0.0,14.0 80.0,10.0 120.0,2.0')
+  expect(historyElem.querySelectorAll('circle.failed')).toHaveLength(2)
+  expect(historyElem.textContent).toBe('Duration trend:3 of 4 run(s), max 2sFirst failed at: July 10, 2020 01:24:45 (1a2b3c4)')
+})
+
+test('test flaky tests and their attempts', () => {
+  const testStatus = Object.assign({}, mockData[1].TestResults[0], {
+    Flaky: true,
+    Attempts: [
+      {Passed: false, Skipped: false, ElapsedTime: 0.1, Output: []},
+      {Passed: true, Skipped: false, ElapsedTime: 0.2, Output: []},
+    ]
+  })
+  const testElements = createFilterTestElements([{PackageName: 'test/package', TestResults: [testStatus]}])
+  const goTestReport = window.GoTestReport(testElements)
+  goTestReport.testResultsClickHandler(testElements.testResultsElem.querySelector('#\\30'),
+                                       false,
+                                       testElements.data,
+                                       {testResults: null, selectedTestGroupColor: null},
+                                       goTestReport.testGroupListHandler)
+  const rowElem = testElements.testGroupListElem.querySelector('.testGroupRow')
+  expect(rowElem.querySelector('.testTitle').textContent).toBe('my_sample_test 2flaky')
+  expect(rowElem.querySelector('.testDetail .attempts').textContent).toBe('Attempts: ✗ 0.1s, ✓ 0.2s')
+})
+
+test('test diffAssertion', () => {
+  const goTestReport = window.GoTestReport(createTestElements())
+  const diff = goTestReport.diffAssertion("{Name: 'foo', Age: 1}", "{Name: 'bar', Age: 1}")
+  expect(diff.expected.filter((token) => token.changed).map((token) => token.text)).toEqual(['foo'])
+  expect(diff.actual.filter((token) => token.changed).map((token) => token.text)).toEqual(['bar'])
+  expect(diff.expected.map((token) => token.text).join('')).toBe("{Name: 'foo', Age: 1}")
+
+  const insertion = goTestReport.diffAssertion('a b c', 'a x b c')
+  expect(insertion.expected.some((token) => token.changed)).toBe(false)
+  expect(insertion.actual.filter((token) => token.changed).map((token) => token.text)).toEqual(['x', ' '])
+})
+
+test('test testGroupListHandler with assertion diffs', () => {
+  const testStatus = Object.assign({}, mockData[0].TestResults[0], {
+    Assertions: [{Expected: '"<b>1</b>"', Actual: '"<b>2</b>"'}]
+  })
+  const goTestReport = window.GoTestReport(createTestElements())
+  const divElem = createDataGroupElement(0, 0)
+  goTestReport.testGroupListHandler(divElem, [{TestResults: [testStatus]}])
+  const assertionElem = divElem.querySelector('.testOutput .assertionDiff')
+  expect(assertionElem.querySelector('.expected pre').innerHTML).toBe('"&lt;b&gt;<del>1</del>&lt;/b&gt;"')
+  expect(assertionElem.querySelector('.actual pre').innerHTML).toBe('"&lt;b&gt;<ins>2</ins>&lt;/b&gt;"')
+
+  const consoleElem = divElem.querySelector('.console')
+  const toggleElem = divElem.querySelector('.rawOutputToggle')
+  expect(consoleElem.classList.contains('hidden')).toBe(true)
+  toggleElem.click()
+  expect(consoleElem.classList.contains('hidden')).toBe(false)
+  expect(toggleElem.textContent).toBe('Hide raw output')
+})
+
+test('test parseAnsiOutput', () => {
+  const goTestReport = window.GoTestReport(createTestElements())
+  expect(goTestReport.parseAnsiOutput('plain')).toEqual([{text: 'plain', classes: []}])
+  expect(goTestReport.parseAnsiOutput('\u001b[1;31mERROR\u001b[22m red\u001b[0m done\u001b[K')).toEqual([
+    {text: 'ERROR', classes: ['ansi-bold', 'ansi-fg-1']},
+    {text: ' red', classes: ['ansi-fg-1']},
+    {text: ' done', classes: []}
+  ])
+  expect(goTestReport.parseAnsiOutput('\u001b[92;44mA\u001b[38;5;208mB\u001b[48;2;10;20;30;39mC')).toEqual([
+    {text: 'A', classes: ['ansi-fg-10', 'ansi-bg-4']},
+    {text: 'B', classes: ['ansi-bg-4'], color: 'rgb(255,135,0)'},
+    {text: 'C', classes: [], backgroundColor: 'rgb(10,20,30)'}
+  ])
+})
+
+test('test testGroupListHandler with ANSI colors', () => {
+  const testStatus = Object.assign({}, mockData[0].TestResults[0], {
+    Output: ['\u001b[31m<b>failed</b>\u001b[0m\n']
+  })
+  const goTestReport = window.GoTestReport(createTestElements())
+  const divElem = createDataGroupElement(0, 0)
+  goTestReport.testGroupListHandler(divElem, [{TestResults: [testStatus]}])
+  const consoleElem = divElem.querySelector('.console')
+  expect(consoleElem.innerHTML).toBe('<span class="ansi-fg-1">&lt;b&gt;failed&lt;/b&gt;</span>\n')
+  expect(consoleElem.textContent).toBe('<b>failed</b>\n')
+})
+
+const hostileName = '<img src=x onerror="alert(1)">'
+const escapedHostileName = '&lt;img src=x onerror="alert(1)"&gt;'
+
+test('test hostile test names render as text in the test tree', () => {
+  const testElements = createTestElements()
+  testElements.data = [{
+    TestResults: [
+      {TestName: `TestHostile/${hostileName}`, Title: '<script>alert(2)</script>', Package: `pkg/${hostileName}`,
+        Output: [], TestFileName: '', TestFunctionDetail: {Line: 0, Col: 0}, ElapsedTime: 0.1}
+    ]
+  }, {
+    TestResults: [
+      {TestName: 'TestOther', Package: `pkg/${hostileName}`, Passed: true, Output: [], TestFileName: '',
+        TestFunctionDetail: {Line: 0, Col: 0}, ElapsedTime: 0.1}
+    ]
+  }]
+  const goTestReport = window.GoTestReport(testElements)
+  const testResultGroup = testElements.testResultsElem.querySelector('#\\30')
+  testResultGroup.classList.add('testResultGroup')
+  goTestReport.testResultsClickHandler(testResultGroup,
+                                       true,
+                                       testElements.data,
+                                       {testResults: null, selectedTestGroupColor: null},
+                                       goTestReport.testGroupListHandler)
+
+  const titles = Array.from(testElements.testGroupListElem.querySelectorAll('.testTitle'))
+  const subtestTitle = titles.find((titleElem) => titleElem.textContent.startsWith('<img'))
+  expect(subtestTitle.textContent).toBe(`${hostileName}(<script>alert(2)</script>)`)
+  expect(subtestTitle.innerHTML).toBe(`${escapedHostileName}(&lt;script&gt;alert(2)&lt;/script&gt;)`)
+  expect(testElements.testGroupListElem.innerHTML).not.toContain('<img')
+  expect(testElements.testGroupListElem.innerHTML).not.toContain('<script')
+})
+
+test('test hostile package and file names render as text in the test details', () => {
+  const testStatus = {
+    TestName: hostileName,
+    Package: hostileName,
+    Output: [`${hostileName}\n`],
+    TestFileName: `${hostileName}_test.go`,
+    TestFunctionDetail: {Line: 3, Col: 1},
+    Shard: hostileName
+  }
+  const goTestReport = window.GoTestReport(createTestElements())
+  const divElem = createDataGroupElement(0, 0)
+  goTestReport.testGroupListHandler(divElem, [{TestResults: [testStatus]}])
+  const testDetailElem = divElem.querySelector('.testOutput .testDetail')
+  expect(testDetailElem.querySelector('.package').innerHTML).toBe(`<strong>Package:</strong> ${escapedHostileName}`)
+  expect(testDetailElem.querySelector('.filename').textContent)
+    .toBe(`Filename: ${hostileName}_test.go   Line: 3 Col: 1`)
+  expect(testDetailElem.querySelector('.shard').textContent).toBe(`Shard: ${hostileName}`)
+  expect(divElem.querySelector('.console').innerHTML).toBe(`${escapedHostileName}\n`)
+  expect(divElem.innerHTML).not.toContain('<img')
+})
+
+test('test testGroupListHandler with gunit log entries', () => {
+  const testStatus = Object.assign({}, mockData[0].TestResults[0], {
+    LogEntries: [
+      {Time: '2021-11-10T21:28:35.123+08:00', Level: 'debug', Message: 'connecting', Fields: {}},
+      {Time: '2021-11-10T21:28:36+08:00', Level: 'WARNING', Message: '<b>slow</b>', Fields: {roomId: '42'}},
+      {Time: '2021-11-10T21:28:37+08:00', Level: 'error', Message: 'failed', Fields: null}
+    ]
+  })
+  const goTestReport = window.GoTestReport(createTestElements())
+  const divElem = createDataGroupElement(0, 0)
+  goTestReport.testGroupListHandler(divElem, [{TestResults: [testStatus]}])
+  const logEntriesElem = divElem.querySelector('.testOutput .logEntries')
+  const rows = Array.from(logEntriesElem.querySelectorAll('tr.logEntry'))
+  expect(rows.map((row) => row.querySelector('.logTime').textContent)).toEqual(['21:28:35.123', '21:28:36', '21:28:37'])
+  expect(rows.map((row) => row.classList.contains('warn'))).toEqual([false, true, false])
+  expect(rows[1].querySelector('.logMessage').innerHTML).toContain('&lt;b&gt;slow&lt;/b&gt;')
+
+  const fieldsRow = logEntriesElem.querySelector('tr.logFields')
+  expect(fieldsRow.classList.contains('collapsed')).toBe(true)
+  expect(fieldsRow.querySelector('pre').textContent).toBe('{\n  "roomId": "42"\n}')
+  const fieldsToggle = rows[1].querySelector('.fieldsToggle')
+  fieldsToggle.click()
+  expect(fieldsRow.classList.contains('collapsed')).toBe(false)
+  expect(fieldsToggle.textContent).toBe('▾ 1 field(s)')
+
+  const labels = Array.from(logEntriesElem.querySelectorAll('.logLevelFilter label'))
+  expect(labels.map((label) => label.textContent)).toEqual([' debug (1)', ' info (0)', ' warn (1)', ' error (1)'])
+  const warnCheckbox = logEntriesElem.querySelector('input[data-level="warn"]')
+  warnCheckbox.checked = false
+  warnCheckbox.dispatchEvent(new Event('change'))
+  expect(rows.map((row) => row.classList.contains('filteredOut'))).toEqual([false, true, false])
+  expect(fieldsRow.classList.contains('filteredOut')).toBe(true)
+})
+
+/** @type {ApiRequest} */
+const mockRequest = {
+  Name: 'login',
+  Method: 'POST',
+  URL: 'https://api.example.com/login?room=1',
+  Headers: {'X-Trace': 'a1', 'Content-Type': 'application/json'},
+  Body: `{"user":"o'brien"}`,
+  Status: '503',
+  ResponseHeaders: {'Retry-After': '5'},
+  ResponseBody: '{"error":"unavailable"}',
+  Latency: '120ms'
+}
+
+test('test formatCurlCommand', () => {
+  const goTestReport = window.GoTestReport(createTestElements())
+  expect(goTestReport.formatCurlCommand(mockRequest)).toBe(
+    `curl -X POST 'https://api.example.com/login?room=1' -H 'Content-Type: application/json' -H 'X-Trace: a1' ` +
+    `--data-raw '{"user":"o'\\''brien"}'`)
+  expect(goTestReport.formatCurlCommand({Method: 'GET', URL: 'https://api.example.com/ping'}))
+    .toBe(`curl 'https://api.example.com/ping'`)
+})
+
+test('test testGroupListHandler with request cards', () => {
+  const testStatus = Object.assign({}, mockData[0].TestResults[0], {Requests: [mockRequest]})
+  const goTestReport = window.GoTestReport(createTestElements())
+  const divElem = createDataGroupElement(0, 0)
+  goTestReport.testGroupListHandler(divElem, [{TestResults: [testStatus]}])
+  const cardElem = divElem.querySelector('.testOutput .requestCards .requestCard')
+  expect(cardElem.querySelector('.method').textContent).toBe('POST')
+  expect(cardElem.querySelector('.url').textContent).toBe('https://api.example.com/login?room=1')
+  expect(cardElem.querySelector('.statusCode').classList.contains('serverError')).toBe(true)
+  expect(cardElem.querySelector('.latency').textContent).toBe('120ms')
+  expect(cardElem.querySelector('.apiName').textContent).toBe('login')
+  const requestHeaders = Array.from(cardElem.querySelectorAll('.request table.headers tr'))
+  expect(requestHeaders.map((row) => row.textContent)).toEqual(['Content-Typeapplication/json', 'X-Tracea1'])
+  expect(cardElem.querySelector('.request pre.body').textContent).toBe('{\n  "user": "o\'brien"\n}')
+  expect(cardElem.querySelector('.response pre.body').textContent).toBe('{\n  "error": "unavailable"\n}')
+  expect(cardElem.querySelector('.copyCurl').textContent).toBe('Copy as curl')
+})